@@ -0,0 +1,781 @@
+package hb
+
+import (
+	"sort"
+	"unicode"
+)
+
+// BidiClass is the Bidi_Class property of a Unicode character, which drives
+// the Unicode Bidirectional Algorithm (UAX #9).
+type BidiClass uint8
+
+const (
+	BidiL   BidiClass = iota // Left-to-right.
+	BidiR                    // Right-to-left.
+	BidiAL                   // Right-to-left Arabic.
+	BidiEN                   // European number.
+	BidiES                   // European number separator.
+	BidiET                   // European number terminator.
+	BidiAN                   // Arabic number.
+	BidiCS                   // Common number separator.
+	BidiNSM                  // Nonspacing mark.
+	BidiBN                   // Boundary neutral.
+	BidiB                    // Paragraph separator.
+	BidiS                    // Segment separator.
+	BidiWS                   // Whitespace.
+	BidiON                   // Other neutrals.
+	BidiLRE                  // Left-to-right embedding.
+	BidiLRO                  // Left-to-right override.
+	BidiRLE                  // Right-to-left embedding.
+	BidiRLO                  // Right-to-left override.
+	BidiPDF                  // Pop directional format.
+	BidiLRI                  // Left-to-right isolate.
+	BidiRLI                  // Right-to-left isolate.
+	BidiFSI                  // First strong isolate.
+	BidiPDI                  // Pop directional isolate.
+)
+
+// bidiClassRange is a range of code points of a single BidiClass.
+type bidiClassRange struct {
+	lo, hi rune
+	class  BidiClass
+}
+
+// BidiClassOf returns the BidiClass of r.
+func BidiClassOf(r rune) BidiClass {
+	i := sort.Search(len(bidiClasses), func(i int) bool { return bidiClasses[i].hi >= r })
+	if i < len(bidiClasses) && bidiClasses[i].lo <= r {
+		return bidiClasses[i].class
+	}
+
+	return BidiL
+}
+
+// BidiLevel is an embedding level of the Unicode Bidirectional Algorithm. Even
+// levels are left-to-right, and odd levels are right-to-left.
+type BidiLevel uint8
+
+// bidiMaxDepth is the deepest explicit embedding level.
+const bidiMaxDepth = 125
+
+// Direction returns DirectionRTL for odd levels, and DirectionLTR for even
+// levels.
+func (l BidiLevel) Direction() Direction {
+	if l&1 == 1 {
+		return DirectionRTL
+	}
+	return DirectionLTR
+}
+
+// BidiRun is a run of text at a single embedding level.
+type BidiRun struct {
+	Start     int       // The byte offset of the run in the paragraph.
+	End       int       // The byte offset of the end of the run in the paragraph.
+	Level     BidiLevel // The resolved embedding level of the run.
+	Direction Direction // The direction to shape the run with, see BufferSetDirection.
+}
+
+// BidiParagraph is a paragraph of text whose embedding levels are resolved
+// using the Unicode Bidirectional Algorithm (UAX #9).
+//
+// Mirrored characters, such as brackets, in right-to-left runs are replaced
+// with their mirrored glyph by HarfBuzz itself, as long as the runs are shaped
+// with their Direction.
+type BidiParagraph struct {
+	text    string
+	level   BidiLevel
+	offsets []int       // The byte offset of every rune, plus the length of the text.
+	runes   []rune      // The runes of the text.
+	classes []BidiClass // The original BidiClass of every rune.
+	levels  []BidiLevel // The resolved level of every rune, before the rules for lines.
+}
+
+// NewBidiParagraph resolves the embedding levels of the UTF-8 encoded text,
+// which is a single paragraph. Any paragraph separator in it is treated as
+// the end of a line.
+//
+// direction sets the paragraph's base direction, DirectionLTR or DirectionRTL.
+// With any other direction it's detected from the first strong character of
+// the text, and is left-to-right if there's none.
+func NewBidiParagraph(text string, direction Direction) *BidiParagraph {
+	p := &BidiParagraph{text: text}
+
+	for offset, r := range text {
+		p.offsets = append(p.offsets, offset)
+		p.runes = append(p.runes, r)
+		p.classes = append(p.classes, BidiClassOf(r))
+	}
+	p.offsets = append(p.offsets, len(text))
+
+	switch direction {
+	case DirectionLTR:
+		p.level = 0
+	case DirectionRTL:
+		p.level = 1
+	default:
+		if bidiFirstStrong(p.classes, 0, len(p.classes)) == BidiR {
+			p.level = 1
+		}
+	}
+
+	p.resolve()
+
+	return p
+}
+
+// Level returns the embedding level of the paragraph.
+func (p *BidiParagraph) Level() BidiLevel {
+	return p.level
+}
+
+// Direction returns the base direction of the paragraph.
+func (p *BidiParagraph) Direction() Direction {
+	return p.level.Direction()
+}
+
+// Levels returns the resolved embedding level of every byte of the text, before
+// the rules applied to the end of lines.
+func (p *BidiParagraph) Levels() []BidiLevel {
+	levels := make([]BidiLevel, len(p.text))
+	for i, level := range p.levels {
+		for j := p.offsets[i]; j < p.offsets[i+1]; j++ {
+			levels[j] = level
+		}
+	}
+
+	return levels
+}
+
+// LineRuns returns the runs of the line spanning the bytes [start, end) of the
+// paragraph, in logical order. Whitespace at the end of the line, and before
+// segment and paragraph separators, is set to the paragraph level. start and
+// end must be at rune boundaries.
+func (p *BidiParagraph) LineRuns(start, end int) []BidiRun {
+	first, last := sort.SearchInts(p.offsets, start), sort.SearchInts(p.offsets, end)
+	levels := append([]BidiLevel(nil), p.levels[first:last]...)
+
+	// Rule L1.
+	trailing := true
+	for i := len(levels) - 1; i >= 0; i-- {
+		switch class := p.classes[first+i]; {
+		case class == BidiS || class == BidiB:
+			levels[i] = p.level
+			trailing = true
+		case bidiIsWhitespace(class):
+			if trailing {
+				levels[i] = p.level
+			}
+		default:
+			trailing = false
+		}
+	}
+
+	var runs []BidiRun
+	for i, level := range levels {
+		offset, next := p.offsets[first+i], p.offsets[first+i+1]
+		if n := len(runs); n > 0 && runs[n-1].Level == level {
+			runs[n-1].End = next
+			continue
+		}
+
+		runs = append(runs, BidiRun{Start: offset, End: next, Level: level, Direction: level.Direction()})
+	}
+
+	return runs
+}
+
+// VisualRuns returns the runs of the line spanning the bytes [start, end) of the
+// paragraph, in visual order, see LineRuns.
+func (p *BidiParagraph) VisualRuns(start, end int) []BidiRun {
+	runs := p.LineRuns(start, end)
+
+	levels := make([]BidiLevel, len(runs))
+	for i, run := range runs {
+		levels[i] = run.Level
+	}
+
+	visual := make([]BidiRun, len(runs))
+	for i, j := range BidiVisualOrder(levels) {
+		visual[i] = runs[j]
+	}
+
+	return visual
+}
+
+// BidiVisualOrder returns the indices of the items of a line, whose embedding
+// levels are given in logical order, in visual order. The glyphs of each item
+// are already in visual order once shaped with its level's direction.
+func BidiVisualOrder(levels []BidiLevel) []int {
+	order := make([]int, len(levels))
+	for i := range order {
+		order[i] = i
+	}
+
+	var highest BidiLevel
+	lowestOdd := BidiLevel(bidiMaxDepth + 2)
+	for _, level := range levels {
+		if level > highest {
+			highest = level
+		}
+		if level&1 == 1 && level < lowestOdd {
+			lowestOdd = level
+		}
+	}
+
+	// Rule L2.
+	for level := highest; level >= lowestOdd && level > 0; level-- {
+		for i := 0; i < len(order); {
+			if levels[order[i]] < level {
+				i++
+				continue
+			}
+
+			j := i
+			for j < len(order) && levels[order[j]] >= level {
+				j++
+			}
+			for a, b := i, j-1; a < b; a, b = a+1, b-1 {
+				order[a], order[b] = order[b], order[a]
+			}
+			i = j
+		}
+	}
+
+	return order
+}
+
+// BidiReorderShapedRuns returns the shaped runs of a line, given in logical
+// order along with their embedding levels, in visual order.
+func BidiReorderShapedRuns(runs []*ShapedRun, levels []BidiLevel) []*ShapedRun {
+	visual := make([]*ShapedRun, len(runs))
+	for i, j := range BidiVisualOrder(levels) {
+		visual[i] = runs[j]
+	}
+
+	return visual
+}
+
+// resolve resolves the embedding levels of the paragraph, following the rules
+// X1 to I2 of UAX #9.
+func (p *BidiParagraph) resolve() {
+	n := len(p.classes)
+	types := append([]BidiClass(nil), p.classes...)
+	p.levels = make([]BidiLevel, n)
+
+	// BD9: match the isolate initiators with their PDIs.
+	matchingPDI := make([]int, n)
+	matched := make([]bool, n)
+	var isolates []int
+	for i, class := range p.classes {
+		matchingPDI[i] = -1
+
+		switch class {
+		case BidiLRI, BidiRLI, BidiFSI:
+			isolates = append(isolates, i)
+		case BidiPDI:
+			if len(isolates) > 0 {
+				matchingPDI[isolates[len(isolates)-1]] = i
+				matched[i] = true
+				isolates = isolates[:len(isolates)-1]
+			}
+		case BidiB:
+			isolates = isolates[:0]
+		}
+	}
+
+	// Rules X1 to X8: the explicit levels and directions.
+	type status struct {
+		level    BidiLevel
+		override BidiClass // BidiON if there's no override.
+		isolate  bool
+	}
+	stack := []status{{level: p.level, override: BidiON}}
+	var overflowIsolates, overflowEmbeddings, validIsolates int
+
+	for i, class := range p.classes {
+		top := stack[len(stack)-1]
+
+		switch class {
+		case BidiRLE, BidiLRE, BidiRLO, BidiLRO:
+			level := bidiNextLevel(top.level, class == BidiRLE || class == BidiRLO)
+			if level <= bidiMaxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				override := BidiON
+				if class == BidiRLO {
+					override = BidiR
+				} else if class == BidiLRO {
+					override = BidiL
+				}
+				stack = append(stack, status{level: level, override: override})
+			} else if overflowIsolates == 0 {
+				overflowEmbeddings++
+			}
+			p.levels[i] = top.level
+
+		case BidiRLI, BidiLRI, BidiFSI:
+			p.levels[i] = top.level
+			if top.override != BidiON {
+				types[i] = top.override
+			}
+
+			rtl := class == BidiRLI
+			if class == BidiFSI {
+				end := matchingPDI[i]
+				if end < 0 {
+					end = n
+				}
+				rtl = bidiFirstStrong(p.classes, i+1, end) == BidiR
+			}
+
+			level := bidiNextLevel(top.level, rtl)
+			if level <= bidiMaxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				validIsolates++
+				stack = append(stack, status{level: level, override: BidiON, isolate: true})
+			} else {
+				overflowIsolates++
+			}
+
+		case BidiPDI:
+			if overflowIsolates > 0 {
+				overflowIsolates--
+			} else if validIsolates > 0 {
+				overflowEmbeddings = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				validIsolates--
+			}
+
+			top = stack[len(stack)-1]
+			p.levels[i] = top.level
+			if top.override != BidiON {
+				types[i] = top.override
+			}
+
+		case BidiPDF:
+			if overflowIsolates > 0 {
+				// Nothing to pop.
+			} else if overflowEmbeddings > 0 {
+				overflowEmbeddings--
+			} else if !top.isolate && len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+			p.levels[i] = top.level
+
+		case BidiB:
+			p.levels[i] = p.level
+
+		case BidiBN:
+			p.levels[i] = top.level
+
+		default:
+			p.levels[i] = top.level
+			if top.override != BidiON {
+				types[i] = top.override
+			}
+		}
+	}
+
+	// The rules below resolve p.levels in place, while the explicit levels are
+	// still needed to find the sos and eos of the sequences.
+	explicit := append([]BidiLevel(nil), p.levels...)
+
+	// Rule X10: the level runs, chained into isolating run sequences.
+	var runs [][]int
+	runStartingAt := make(map[int]int)
+	for i, class := range p.classes {
+		if bidiIsRemoved(class) {
+			continue
+		}
+
+		if n := len(runs); n > 0 && explicit[runs[n-1][0]] == explicit[i] {
+			runs[n-1] = append(runs[n-1], i)
+			continue
+		}
+
+		runStartingAt[i] = len(runs)
+		runs = append(runs, []int{i})
+	}
+
+	for _, run := range runs {
+		if first := run[0]; p.classes[first] == BidiPDI && matched[first] {
+			continue
+		}
+
+		sequence := append([]int(nil), run...)
+		for {
+			last := sequence[len(sequence)-1]
+			if !bidiIsIsolateInitiator(p.classes[last]) || matchingPDI[last] < 0 {
+				break
+			}
+
+			next, ok := runStartingAt[matchingPDI[last]]
+			if !ok {
+				break
+			}
+			sequence = append(sequence, runs[next]...)
+		}
+
+		p.resolveSequence(sequence, types, explicit)
+	}
+
+	// The characters removed by the rule X9 take the level of the preceding
+	// one, so that they stay within their run.
+	for i, class := range p.classes {
+		if bidiIsRemoved(class) {
+			if i > 0 {
+				p.levels[i] = p.levels[i-1]
+			} else {
+				p.levels[i] = p.level
+			}
+		}
+	}
+}
+
+// resolveSequence resolves the levels of an isolating run sequence, following
+// the rules W1 to I2 of UAX #9.
+func (p *BidiParagraph) resolveSequence(sequence []int, types []BidiClass, explicit []BidiLevel) {
+	first, last := sequence[0], sequence[len(sequence)-1]
+	level := explicit[first]
+
+	prevLevel := p.level
+	for i := first - 1; i >= 0; i-- {
+		if !bidiIsRemoved(p.classes[i]) {
+			prevLevel = explicit[i]
+			break
+		}
+	}
+
+	nextLevel := p.level
+	if !bidiIsIsolateInitiator(p.classes[last]) {
+		for i := last + 1; i < len(p.classes); i++ {
+			if !bidiIsRemoved(p.classes[i]) {
+				nextLevel = explicit[i]
+				break
+			}
+		}
+	}
+
+	sos := bidiLevelClass(maxBidiLevel(prevLevel, level))
+	eos := bidiLevelClass(maxBidiLevel(nextLevel, level))
+	embedding := bidiLevelClass(level)
+
+	t := make([]BidiClass, len(sequence))
+	for k, i := range sequence {
+		t[k] = types[i]
+	}
+
+	// Rule W1.
+	for k := range t {
+		if t[k] != BidiNSM {
+			continue
+		}
+
+		switch {
+		case k == 0:
+			t[k] = sos
+		case bidiIsIsolateInitiator(t[k-1]) || t[k-1] == BidiPDI:
+			t[k] = BidiON
+		default:
+			t[k] = t[k-1]
+		}
+	}
+
+	// Rules W2 and W3.
+	strong := sos
+	for k := range t {
+		switch t[k] {
+		case BidiL, BidiR:
+			strong = t[k]
+		case BidiAL:
+			strong = BidiAL
+			t[k] = BidiR
+		case BidiEN:
+			if strong == BidiAL {
+				t[k] = BidiAN
+			}
+		}
+	}
+
+	// Rule W4.
+	for k := 1; k+1 < len(t); k++ {
+		if t[k] == BidiES && t[k-1] == BidiEN && t[k+1] == BidiEN {
+			t[k] = BidiEN
+		} else if t[k] == BidiCS && t[k-1] == t[k+1] && (t[k-1] == BidiEN || t[k-1] == BidiAN) {
+			t[k] = t[k-1]
+		}
+	}
+
+	// Rule W5.
+	for k := 0; k < len(t); k++ {
+		if t[k] != BidiET {
+			continue
+		}
+
+		end := k
+		for end < len(t) && t[end] == BidiET {
+			end++
+		}
+		if (k > 0 && t[k-1] == BidiEN) || (end < len(t) && t[end] == BidiEN) {
+			for j := k; j < end; j++ {
+				t[j] = BidiEN
+			}
+		}
+		k = end - 1
+	}
+
+	// Rules W6 and W7.
+	strong = sos
+	for k := range t {
+		switch t[k] {
+		case BidiES, BidiET, BidiCS:
+			t[k] = BidiON
+		case BidiL, BidiR:
+			strong = t[k]
+		case BidiEN:
+			if strong == BidiL {
+				t[k] = BidiL
+			}
+		}
+	}
+
+	// Rule N0: paired brackets.
+	for _, pair := range p.bracketPairs(sequence, t) {
+		opening, closing := pair[0], pair[1]
+
+		inside := BidiON
+		for k := opening + 1; k < closing; k++ {
+			if s := bidiStrongClass(t[k]); s == embedding {
+				inside = s
+				break
+			} else if s != BidiON {
+				inside = s
+			}
+		}
+		if inside == BidiON {
+			continue
+		}
+
+		direction := embedding
+		if inside != embedding {
+			preceding := sos
+			for k := opening - 1; k >= 0; k-- {
+				if s := bidiStrongClass(t[k]); s != BidiON {
+					preceding = s
+					break
+				}
+			}
+			if preceding != embedding {
+				direction = preceding
+			}
+		}
+
+		for _, k := range [2]int{opening, closing} {
+			t[k] = direction
+			for j := k + 1; j < len(t) && p.classes[sequence[j]] == BidiNSM; j++ {
+				t[j] = direction
+			}
+		}
+	}
+
+	// Rules N1 and N2.
+	for k := 0; k < len(t); k++ {
+		if !bidiIsNeutral(t[k]) {
+			continue
+		}
+
+		end := k
+		for end < len(t) && bidiIsNeutral(t[end]) {
+			end++
+		}
+
+		leading, trailing := sos, eos
+		if k > 0 {
+			leading = bidiStrongClass(t[k-1])
+		}
+		if end < len(t) {
+			trailing = bidiStrongClass(t[end])
+		}
+
+		direction := embedding
+		if leading == trailing {
+			direction = leading
+		}
+		for j := k; j < end; j++ {
+			t[j] = direction
+		}
+		k = end - 1
+	}
+
+	// Rules I1 and I2.
+	for k, i := range sequence {
+		if level&1 == 0 {
+			switch t[k] {
+			case BidiR:
+				p.levels[i] = level + 1
+			case BidiAN, BidiEN:
+				p.levels[i] = level + 2
+			}
+		} else if t[k] == BidiL || t[k] == BidiEN || t[k] == BidiAN {
+			p.levels[i] = level + 1
+		}
+	}
+}
+
+// bracketPairs returns the indices, in the sequence, of its paired brackets
+// sorted by their opening bracket, following the rule BD16 of UAX #9.
+func (p *BidiParagraph) bracketPairs(sequence []int, t []BidiClass) [][2]int {
+	const maxOpenings = 63
+
+	type opening struct {
+		pair rune
+		k    int
+	}
+	var openings []opening
+	var pairs [][2]int
+
+	ufuncs := UnicodeFuncsGetDefault()
+	for k, i := range sequence {
+		if t[k] != BidiON || p.classes[i] != BidiON {
+			continue
+		}
+
+		r := p.runes[i]
+		switch {
+		case unicode.Is(unicode.Ps, r):
+			pair := rune(UnicodeMirroring(ufuncs, Codepoint(r)))
+			if pair == r {
+				continue
+			}
+			if len(openings) == maxOpenings {
+				sort.Slice(pairs, func(a, b int) bool { return pairs[a][0] < pairs[b][0] })
+				return pairs
+			}
+			openings = append(openings, opening{pair: bidiCanonicalBracket(pair), k: k})
+
+		case unicode.Is(unicode.Pe, r):
+			r = bidiCanonicalBracket(r)
+			for j := len(openings) - 1; j >= 0; j-- {
+				if openings[j].pair == r {
+					pairs = append(pairs, [2]int{openings[j].k, k})
+					openings = openings[:j]
+					break
+				}
+			}
+		}
+	}
+
+	sort.Slice(pairs, func(a, b int) bool { return pairs[a][0] < pairs[b][0] })
+	return pairs
+}
+
+// bidiFirstStrong returns BidiL or BidiR for the first strong character in
+// classes[start:end], skipping isolates, or BidiON if there's none. It stops at
+// a paragraph separator. An unmatched PDI doesn't end any isolate, so it's
+// skipped, as P2 requires.
+func bidiFirstStrong(classes []BidiClass, start, end int) BidiClass {
+	var depth int
+	for i := start; i < end; i++ {
+		switch classes[i] {
+		case BidiL:
+			if depth == 0 {
+				return BidiL
+			}
+		case BidiR, BidiAL:
+			if depth == 0 {
+				return BidiR
+			}
+		case BidiLRI, BidiRLI, BidiFSI:
+			depth++
+		case BidiPDI:
+			if depth > 0 {
+				depth--
+			}
+		case BidiB:
+			return BidiON
+		}
+	}
+
+	return BidiON
+}
+
+// bidiNextLevel returns the least odd level, if rtl, or the least even level
+// greater than level.
+func bidiNextLevel(level BidiLevel, rtl bool) BidiLevel {
+	if rtl {
+		return (level + 1) | 1
+	}
+	return (level + 2) &^ 1
+}
+
+// bidiCanonicalBracket maps the brackets canonically equivalent to others, as
+// BD16 requires.
+func bidiCanonicalBracket(r rune) rune {
+	switch r {
+	case 0x2329:
+		return 0x3008
+	case 0x232A:
+		return 0x3009
+	}
+	return r
+}
+
+// bidiLevelClass returns the strong class of the direction of level.
+func bidiLevelClass(level BidiLevel) BidiClass {
+	if level&1 == 1 {
+		return BidiR
+	}
+	return BidiL
+}
+
+// bidiStrongClass returns the direction class, per the rules N0 to N2, of a
+// resolved class, BidiL or BidiR, or BidiON if it's neutral.
+func bidiStrongClass(class BidiClass) BidiClass {
+	switch class {
+	case BidiL:
+		return BidiL
+	case BidiR, BidiAL, BidiEN, BidiAN:
+		return BidiR
+	}
+	return BidiON
+}
+
+// bidiIsRemoved reports whether the class is removed by the rule X9.
+func bidiIsRemoved(class BidiClass) bool {
+	switch class {
+	case BidiRLE, BidiLRE, BidiRLO, BidiLRO, BidiPDF, BidiBN:
+		return true
+	}
+	return false
+}
+
+func bidiIsIsolateInitiator(class BidiClass) bool {
+	return class == BidiLRI || class == BidiRLI || class == BidiFSI
+}
+
+// bidiIsNeutral reports whether the class is a neutral or isolate formatting
+// character, as the rules N1 and N2 define.
+func bidiIsNeutral(class BidiClass) bool {
+	switch class {
+	case BidiB, BidiS, BidiWS, BidiON, BidiLRI, BidiRLI, BidiFSI, BidiPDI:
+		return true
+	}
+	return false
+}
+
+// bidiIsWhitespace reports whether the class is reset to the paragraph level
+// at the end of a line by the rule L1.
+func bidiIsWhitespace(class BidiClass) bool {
+	switch class {
+	case BidiWS, BidiLRI, BidiRLI, BidiFSI, BidiPDI:
+		return true
+	}
+	return bidiIsRemoved(class)
+}
+
+func maxBidiLevel(a, b BidiLevel) BidiLevel {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,123 @@
+package hb
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestBidiParagraphDirection(t *testing.T) {
+	tests := []struct {
+		text string
+		want Direction
+	}{
+		{"abc", DirectionLTR},
+		{"שלום", DirectionRTL},
+		{"123 שלום", DirectionRTL},
+		{"\u2067abc\u2069 שלום", DirectionRTL}, // the isolate is skipped
+		{"\u2066שלום\u2069 abc", DirectionLTR},
+		{"\u2069שלום", DirectionRTL}, // an unmatched PDI is skipped
+		{"\u2069abc", DirectionLTR},
+		{"\u2068שלום", DirectionLTR}, // an unmatched isolate runs to the end
+	}
+
+	for _, test := range tests {
+		if got := NewBidiParagraph(test.text, DirectionInvalid).Direction(); got != test.want {
+			t.Errorf("NewBidiParagraph(%+q).Direction() = %v, want %v", test.text, got, test.want)
+		}
+	}
+}
+
+// bidiCharacterTests are written in the format of BidiCharacterTest.txt from
+// the Unicode Character Database. The fields are the code points of the text,
+// the paragraph direction (0 for LTR, 1 for RTL, 2 for auto), the resolved
+// paragraph level, the resolved level of every code point, x for the ones
+// removed by X9, and the visual order of the code points left.
+const bidiCharacterTests = `
+0061 0020 05D0 0020 05D1;0;0;0 0 1 1 1;0 1 4 3 2                   # mixed, LTR
+0061 0020 05D0 0020 05D1;1;1;2 1 1 1 1;4 3 2 1 0                   # mixed, RTL
+05D0 0020 0031 002C 0032 0020 05D1;2;1;1 1 2 2 2 1 1;6 5 2 3 4 1 0 # W4 common separator between numbers
+0031 002B 0032;1;1;2 2 2;0 1 2                                     # W4 European separator between numbers
+0627 0020 0024 0031;2;1;1 1 1 2;3 2 1 0                            # W5 European terminator before a number
+0661 002C 0662;1;1;2 2 2;0 1 2                                     # W4 common separator between Arabic numbers
+0627 0031;2;1;1 2;1 0                                              # W2 European number after an Arabic letter
+0061 0028 05D0 0029;0;0;0 0 1 0;0 1 2 3                            # N0 brackets around the opposite direction
+05D0 0028 0061 0029 0020 05D1;1;1;1 1 2 1 1 1;5 4 3 2 1 0          # N0 brackets in the embedding direction
+05D0 0028 0029 0061;0;0;1 0 0 0;0 1 2 3                            # N0 empty brackets, N1 and N2
+0061 2067 05D0 0031 2069 0062;0;0;0 0 1 2 0 0;0 1 3 2 4 5          # X5a to X6a RLI and PDI
+05D0 2068 0061 2069;2;1;1 1 2 1;3 2 1 0                            # X5c FSI
+0061 202B 0062 202C 0063;0;0;0 x 2 x 0;0 2 4                       # X2 and X7 RLE and PDF
+0061 202E 0062 0063 202C;0;0;0 x 1 1 x;0 3 2                       # X4 RLO
+05D0 0020 0061 0020 0020;0;0;1 0 0 0 0;0 1 2 3 4                   # L1 trailing whitespace
+0061 0020 05D0 0020;1;1;2 1 1 1;3 2 1 0                            # L1 trailing whitespace, RTL
+05D0 0009 05D1;0;0;1 0 1;0 1 2                                     # L1 segment separator
+05D0 0300 0061;0;0;1 1 0;1 0 2                                     # W1 non-spacing mark
+0061 202B 05D0 202A 0062 202C 202C;0;0;0 x 1 x 2 x x;0 4 2         # X2, X3 nested embeddings
+2069 05D0 202C;0;0;0 1 x;0 1                                       # unmatched PDI and PDF
+`
+
+func TestBidiParagraphLevels(t *testing.T) {
+	for _, line := range strings.Split(bidiCharacterTests, "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Split(line, ";")
+		if len(fields) != 5 {
+			continue
+		}
+
+		var text strings.Builder
+		for _, field := range strings.Fields(fields[0]) {
+			r, err := strconv.ParseUint(field, 16, 32)
+			if err != nil {
+				t.Fatalf("%q: %v", line, err)
+			}
+			text.WriteRune(rune(r))
+		}
+
+		direction := DirectionInvalid
+		switch strings.TrimSpace(fields[1]) {
+		case "0":
+			direction = DirectionLTR
+		case "1":
+			direction = DirectionRTL
+		}
+
+		p := NewBidiParagraph(text.String(), direction)
+		if got := strconv.Itoa(int(p.Level())); got != strings.TrimSpace(fields[2]) {
+			t.Errorf("%s: got paragraph level %s, want %s", fields[0], got, strings.TrimSpace(fields[2]))
+		}
+
+		// The levels of the line, after L1, of the code points not removed.
+		runs := p.LineRuns(0, text.Len())
+		var levels []BidiLevel
+		var indices []int
+		var gotLevels []string
+		var i int
+		for offset, r := range text.String() {
+			switch BidiClassOf(r) {
+			case BidiLRE, BidiRLE, BidiLRO, BidiRLO, BidiPDF, BidiBN:
+				gotLevels = append(gotLevels, "x")
+			default:
+				for _, run := range runs {
+					if offset >= run.Start && offset < run.End {
+						levels = append(levels, run.Level)
+						indices = append(indices, i)
+						gotLevels = append(gotLevels, strconv.Itoa(int(run.Level)))
+					}
+				}
+			}
+			i++
+		}
+
+		if got, want := strings.Join(gotLevels, " "), strings.Join(strings.Fields(fields[3]), " "); got != want {
+			t.Errorf("%s: got levels %s, want %s", fields[0], got, want)
+		}
+
+		var gotOrder []string
+		for _, j := range BidiVisualOrder(levels) {
+			gotOrder = append(gotOrder, strconv.Itoa(indices[j]))
+		}
+		if got, want := strings.Join(gotOrder, " "), strings.Join(strings.Fields(fields[4]), " "); got != want {
+			t.Errorf("%s: got visual order %s, want %s", fields[0], got, want)
+		}
+	}
+}
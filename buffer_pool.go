@@ -0,0 +1,162 @@
+package hb
+
+import "sync"
+
+// BufferConfig holds the settings every buffer of a BufferPool is handed out
+// with. The zero value is the configuration of a buffer newly created with
+// BufferCreate.
+type BufferConfig struct {
+	Flags          BufferFlags  // The buffer flags, see BufferSetFlags.
+	ClusterLevel   ClusterLevel // The cluster level, see BufferSetClusterLevel.
+	InvisibleGlyph Codepoint    // The glyph replacing invisible characters, see BufferSetInvisibleGlyph.
+	NotFoundGlyph  Codepoint    // The glyph replacing characters not found in the font, see BufferSetNotFoundGlyph.
+
+	// ReplacementCodepoint replaces invalid input, see
+	// BufferSetReplacementCodepoint. Zero means BufferReplacementCodepointDefault.
+	ReplacementCodepoint Codepoint
+
+	// PreAllocate is the number of items new buffers are pre-allocated for,
+	// see BufferPreAllocate.
+	PreAllocate uint32
+}
+
+// apply sets the configuration on buffer.
+func (c *BufferConfig) apply(buffer Buffer) {
+	BufferSetFlags(buffer, c.Flags)
+	BufferSetClusterLevel(buffer, c.ClusterLevel)
+	BufferSetInvisibleGlyph(buffer, c.InvisibleGlyph)
+	BufferSetNotFoundGlyph(buffer, c.NotFoundGlyph)
+
+	replacement := c.ReplacementCodepoint
+	if replacement == 0 {
+		replacement = BufferReplacementCodepointDefault
+	}
+	BufferSetReplacementCodepoint(buffer, replacement)
+}
+
+// BufferPoolStats holds the metrics of a BufferPool.
+type BufferPoolStats struct {
+	Gets    uint64 // The number of buffers handed out by Get.
+	Puts    uint64 // The number of buffers returned by Put.
+	Creates uint64 // The number of buffers created by the pool.
+	Drops   uint64 // The number of returned buffers destroyed instead of being kept.
+	Idle    int    // The number of buffers currently kept in the pool.
+
+	// Grows is the number of times a returned buffer was holding more items
+	// than it ever did before, which means HarfBuzz had to grow its allocation.
+	Grows uint64
+
+	// MaxLength is the largest number of items a returned buffer was holding.
+	MaxLength uint32
+}
+
+// BufferPool keeps a set of buffers to be reused, saving the cost of creating
+// and growing a new buffer for every text to shape. It's safe for concurrent
+// use.
+type BufferPool struct {
+	config  BufferConfig
+	maxIdle int
+
+	mu        sync.Mutex
+	destroyed bool
+	idle      []Buffer
+	capacity  map[Buffer]uint32
+	stats     BufferPoolStats
+}
+
+// NewBufferPool creates a BufferPool handing out buffers with the given
+// config. At most maxIdle buffers are kept in the pool, and zero or less means
+// no limit.
+func NewBufferPool(config BufferConfig, maxIdle int) *BufferPool {
+	return &BufferPool{
+		config:   config,
+		maxIdle:  maxIdle,
+		capacity: make(map[Buffer]uint32),
+	}
+}
+
+// Get returns an empty buffer set up with the pool's config. Return it to the
+// pool using Put once done with it, instead of BufferDestroy.
+func (p *BufferPool) Get() Buffer {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stats.Gets++
+
+	if n := len(p.idle); n > 0 {
+		buffer := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		return buffer
+	}
+
+	buffer := BufferCreate()
+	p.config.apply(buffer)
+	if p.config.PreAllocate > 0 && BufferPreAllocate(buffer, p.config.PreAllocate) {
+		p.capacity[buffer] = p.config.PreAllocate
+	}
+	p.stats.Creates++
+
+	return buffer
+}
+
+// Put resets the buffer and returns it to the pool. Buffers which failed to
+// allocate, or which don't fit in the pool, are destroyed instead. The buffer
+// must not be used afterwards.
+func (p *BufferPool) Put(buffer Buffer) {
+	if buffer == nil {
+		return
+	}
+
+	length := BufferGetLength(buffer)
+	ok := BufferAllocationSuccessful(buffer)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stats.Puts++
+	if length > p.capacity[buffer] {
+		p.capacity[buffer] = length
+		p.stats.Grows++
+	}
+	if length > p.stats.MaxLength {
+		p.stats.MaxLength = length
+	}
+
+	if !ok || p.destroyed || (p.maxIdle > 0 && len(p.idle) >= p.maxIdle) {
+		delete(p.capacity, buffer)
+		BufferDestroy(buffer)
+		p.stats.Drops++
+		return
+	}
+
+	// BufferReset also drops the settings the user may have changed, unlike
+	// BufferClearContents, so the config is applied again.
+	BufferReset(buffer)
+	p.config.apply(buffer)
+	p.idle = append(p.idle, buffer)
+}
+
+// Stats returns the metrics of the pool.
+func (p *BufferPool) Stats() BufferPoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := p.stats
+	stats.Idle = len(p.idle)
+
+	return stats
+}
+
+// Destroy destroys the buffers kept in the pool. Buffers handed out by Get and
+// returned afterwards are destroyed by Put.
+func (p *BufferPool) Destroy() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, buffer := range p.idle {
+		delete(p.capacity, buffer)
+		BufferDestroy(buffer)
+	}
+	p.idle = nil
+	p.destroyed = true
+}
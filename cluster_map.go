@@ -0,0 +1,167 @@
+package hb
+
+import (
+	"fmt"
+	"sort"
+	"unicode/utf8"
+)
+
+// TextUnit is the unit of text offsets, such as the clusters of a buffer or
+// the ones produced by BufferGetClusterMap.
+type TextUnit int
+
+const (
+	TextUnitBytes TextUnit = iota // UTF-8 bytes, the unit of Go strings.
+	TextUnitRunes                 // Unicode codepoints, the unit of Go []rune.
+	TextUnitUTF16                 // UTF-16 code units, the unit of JavaScript strings.
+)
+
+// TextRange is a half-open range [Start, End) of text units or glyph indices.
+type TextRange struct {
+	Start int
+	End   int
+}
+
+// ClusterMap maps the glyphs of a shaped buffer to the text they came from,
+// and the other way around.
+type ClusterMap struct {
+	// GlyphToText holds, for every glyph in the buffer, the range of the text
+	// its cluster covers.
+	GlyphToText []TextRange
+
+	// TextToGlyph holds, for every unit of the text, the range of the glyphs in
+	// the buffer its cluster is shaped into. Units which no glyph came from,
+	// such as the context around an item, have an empty range.
+	TextToGlyph []TextRange
+}
+
+// BufferGetClusterMap builds the ClusterMap of the shaped buffer, whose content
+// was added from the whole text, with offsets in unit. text is the same text as
+// a Go string, and source is the unit of the clusters of the buffer, which
+// depends on how its content was added: TextUnitBytes for BufferAddUTF8,
+// TextUnitUTF16 for BufferAddUTF16, and TextUnitRunes for BufferAddUTF32 and
+// BufferAddCodepoints. It panics if a cluster is past the end of the text.
+//
+// A cluster covers the text from its own offset up to the offset of the next
+// greater cluster in the buffer. The glyph range of a cluster spans from its
+// first to its last glyph in the buffer, which works the same for both LTR and
+// RTL runs. With ClusterLevelCharacters, glyphs of a cluster may not be
+// adjacent, in which case the range covers the glyphs in between as well.
+func BufferGetClusterMap(buffer Buffer, text string, source, unit TextUnit) *ClusterMap {
+	return BufferGetItemClusterMap(buffer, text, source, 0, -1, unit)
+}
+
+// BufferGetItemClusterMap is like BufferGetClusterMap, but for a buffer whose
+// content was added with one of the BufferAdd*Item functions, given the same
+// itemOffset and itemLength, in source units. The last cluster ends at the end
+// of the item, so the context after it gets an empty range. It panics if the
+// item is out of the range of text, or a cluster is out of the item.
+func BufferGetItemClusterMap(buffer Buffer, text string, source TextUnit, itemOffset, itemLength int, unit TextUnit) *ClusterMap {
+	offsets := textUnitConversion(text, source, unit)
+	checkItem(len(offsets)-1, itemOffset, itemLength)
+
+	itemEnd := len(offsets) - 1
+	if itemLength >= 0 {
+		itemEnd = itemOffset + itemLength
+	}
+
+	infos := BufferGetGlyphInfosView(buffer)
+
+	clusters := make([]uint32, 0, len(infos))
+	glyphs := make(map[uint32]TextRange, len(infos))
+	for i, info := range infos {
+		if int(info.Cluster) < itemOffset || int(info.Cluster) >= itemEnd {
+			panic(fmt.Sprintf("hb: cluster %d out of the item [%d:%d]", info.Cluster, itemOffset, itemEnd))
+		}
+
+		if r, ok := glyphs[info.Cluster]; ok {
+			if i < r.Start {
+				r.Start = i
+			}
+			if i+1 > r.End {
+				r.End = i + 1
+			}
+			glyphs[info.Cluster] = r
+			continue
+		}
+
+		glyphs[info.Cluster] = TextRange{Start: i, End: i + 1}
+		clusters = append(clusters, info.Cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i] < clusters[j] })
+
+	texts := make(map[uint32]TextRange, len(clusters))
+	for i, cluster := range clusters {
+		end := itemEnd
+		if i+1 < len(clusters) {
+			end = int(clusters[i+1])
+		}
+
+		texts[cluster] = TextRange{Start: offsets[cluster], End: offsets[end]}
+	}
+
+	m := &ClusterMap{
+		GlyphToText: make([]TextRange, len(infos)),
+		TextToGlyph: make([]TextRange, offsets[len(offsets)-1]),
+	}
+
+	for i, info := range infos {
+		m.GlyphToText[i] = texts[info.Cluster]
+	}
+
+	for _, cluster := range clusters {
+		t, g := texts[cluster], glyphs[cluster]
+		for u := t.Start; u < t.End; u++ {
+			m.TextToGlyph[u] = g
+		}
+	}
+
+	return m
+}
+
+// textUnitConversion returns the offset, in the unit to, of every offset of
+// text in the unit from, plus one for the end of the text. Offsets in the middle
+// of an encoded rune get the offset of the rune.
+func textUnitConversion(text string, from, to TextUnit) []int {
+	offsets := make([]int, 0, textUnitLength(text, from)+1)
+
+	var offset int
+	for _, r := range text {
+		for j := 0; j < textUnitSize(r, from); j++ {
+			offsets = append(offsets, offset)
+		}
+		offset += textUnitSize(r, to)
+	}
+	offsets = append(offsets, offset)
+
+	return offsets
+}
+
+// textUnitLength returns the length of text in unit.
+func textUnitLength(text string, unit TextUnit) int {
+	switch unit {
+	case TextUnitBytes:
+		return len(text)
+	case TextUnitRunes:
+		return utf8.RuneCountInString(text)
+	}
+
+	var length int
+	for _, r := range text {
+		length += textUnitSize(r, unit)
+	}
+	return length
+}
+
+// textUnitSize returns the size of r encoded in unit.
+func textUnitSize(r rune, unit TextUnit) int {
+	switch unit {
+	case TextUnitBytes:
+		return utf8.RuneLen(r)
+	case TextUnitUTF16:
+		if r >= 0x10000 {
+			return 2
+		}
+	}
+	return 1
+}
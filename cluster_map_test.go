@@ -0,0 +1,152 @@
+package hb
+
+import (
+	"testing"
+	"unicode/utf16"
+)
+
+func TestBufferGetClusterMap(t *testing.T) {
+	font := buildTestFont(t, testFontSpec{
+		runes:          "fiae\u00e9\U0001d400א",
+		ligatures:      []string{"fi"},
+		decompositions: map[rune]string{'\u00e9': "ae"},
+	})
+
+	type want struct {
+		glyphToText []TextRange
+		textToGlyph []TextRange
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want map[TextUnit]want
+	}{
+		{
+			// The ligature of fi is a glyph for 2 runes, é is decomposed into
+			// 2 glyphs and 𝐀 takes 4 bytes and 2 UTF-16 units.
+			name: "ltr",
+			text: "fi\u00e9\U0001d400",
+			want: map[TextUnit]want{
+				TextUnitBytes: {
+					glyphToText: []TextRange{{0, 2}, {2, 4}, {2, 4}, {4, 8}},
+					textToGlyph: []TextRange{{0, 1}, {0, 1}, {1, 3}, {1, 3}, {3, 4}, {3, 4}, {3, 4}, {3, 4}},
+				},
+				TextUnitRunes: {
+					glyphToText: []TextRange{{0, 2}, {2, 3}, {2, 3}, {3, 4}},
+					textToGlyph: []TextRange{{0, 1}, {0, 1}, {1, 3}, {3, 4}},
+				},
+				TextUnitUTF16: {
+					glyphToText: []TextRange{{0, 2}, {2, 3}, {2, 3}, {3, 5}},
+					textToGlyph: []TextRange{{0, 1}, {0, 1}, {1, 3}, {3, 4}, {3, 4}},
+				},
+			},
+		},
+		{
+			// The glyphs are in visual order, so the first one is the ligature
+			// at the end of the text.
+			name: "rtl",
+			text: "א\U0001d400fi",
+			want: map[TextUnit]want{
+				TextUnitBytes: {
+					glyphToText: []TextRange{{6, 8}, {2, 6}, {0, 2}},
+					textToGlyph: []TextRange{{2, 3}, {2, 3}, {1, 2}, {1, 2}, {1, 2}, {1, 2}, {0, 1}, {0, 1}},
+				},
+				TextUnitRunes: {
+					glyphToText: []TextRange{{2, 4}, {1, 2}, {0, 1}},
+					textToGlyph: []TextRange{{2, 3}, {1, 2}, {0, 1}, {0, 1}},
+				},
+				TextUnitUTF16: {
+					glyphToText: []TextRange{{3, 5}, {1, 3}, {0, 1}},
+					textToGlyph: []TextRange{{2, 3}, {1, 2}, {1, 2}, {0, 1}, {0, 1}},
+				},
+			},
+		},
+	}
+
+	sources := []struct {
+		unit TextUnit
+		add  func(buffer Buffer, text string)
+	}{
+		{TextUnitBytes, BufferAddUTF8},
+		{TextUnitUTF16, func(buffer Buffer, text string) { BufferAddUTF16(buffer, utf16.Encode([]rune(text))) }},
+		{TextUnitRunes, func(buffer Buffer, text string) {
+			var codepoints []Codepoint
+			for _, r := range text {
+				codepoints = append(codepoints, Codepoint(r))
+			}
+			BufferAddCodepoints(buffer, codepoints)
+		}},
+	}
+
+	for _, tt := range tests {
+		for _, source := range sources {
+			buffer := BufferCreate()
+			source.add(buffer, tt.text)
+			BufferGuessSegmentProperties(buffer)
+			Shape(font, buffer, nil)
+
+			for unit, w := range tt.want {
+				m := BufferGetClusterMap(buffer, tt.text, source.unit, unit)
+				if !equalTextRanges(m.GlyphToText, w.glyphToText) {
+					t.Errorf("%s: source %d, unit %d: got GlyphToText %v, want %v", tt.name, source.unit, unit, m.GlyphToText, w.glyphToText)
+				}
+				if !equalTextRanges(m.TextToGlyph, w.textToGlyph) {
+					t.Errorf("%s: source %d, unit %d: got TextToGlyph %v, want %v", tt.name, source.unit, unit, m.TextToGlyph, w.textToGlyph)
+				}
+			}
+
+			BufferDestroy(buffer)
+		}
+	}
+}
+
+func TestBufferGetClusterMapMismatch(t *testing.T) {
+	text := "\U0001d400\U0001d400"
+
+	buffer := BufferCreate()
+	defer BufferDestroy(buffer)
+
+	// The clusters of the last rune are at byte 4, past the 2 runes of text.
+	BufferAddUTF8(buffer, text)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("got no panic for clusters in bytes read as runes")
+		}
+	}()
+	BufferGetClusterMap(buffer, text, TextUnitRunes, TextUnitRunes)
+}
+
+func TestTextUnitConversion(t *testing.T) {
+	text := "a\u00e9\U0001d400"
+
+	tests := []struct {
+		from, to TextUnit
+		want     []int
+	}{
+		{TextUnitBytes, TextUnitRunes, []int{0, 1, 1, 2, 2, 2, 2, 3}},
+		{TextUnitBytes, TextUnitUTF16, []int{0, 1, 1, 2, 2, 2, 2, 4}},
+		{TextUnitUTF16, TextUnitBytes, []int{0, 1, 3, 3, 7}},
+		{TextUnitRunes, TextUnitUTF16, []int{0, 1, 2, 4}},
+		{TextUnitRunes, TextUnitRunes, []int{0, 1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		if got := textUnitConversion(text, tt.from, tt.to); !equalInts(got, tt.want) {
+			t.Errorf("from %d to %d: got %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func equalTextRanges(a, b []TextRange) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
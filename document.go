@@ -0,0 +1,213 @@
+package hb
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"math"
+	"runtime"
+	"unicode/utf8"
+)
+
+// DocumentOptions holds the options of ShapeDocument.
+type DocumentOptions struct {
+	Shape   ShapeOptions // The options every paragraph is shaped with, see ShapeText.
+	Workers int          // The number of paragraphs shaped at once, runtime.NumCPU() if zero or less.
+}
+
+// ShapedParagraph is a single paragraph of a document shaped by ShapeDocument.
+type ShapedParagraph struct {
+	Index  int        // The index of the paragraph in the document.
+	Offset int        // The byte offset of the paragraph in the document.
+	Text   string     // The text of the paragraph, without its separator.
+	Run    *ShapedRun // The shaped paragraph, whose clusters are byte offsets in Text.
+	Err    error      // The error which stopped the shaping, if any. Run is nil then.
+}
+
+// ShapeDocument reads the UTF-8 encoded document from r, splits it into
+// paragraphs, and shapes them with font on a pool of opts.Workers goroutines,
+// each one using its own buffer.
+//
+// The paragraphs are sent to the returned channel in the document's order, and
+// the channel is closed once they're all sent. If reading or shaping fails, the
+// last value sent has Err set. Once ctx is canceled, the channel is closed
+// without sending the rest of the paragraphs, the last one sent possibly
+// having ctx's error set, so callers may stop receiving after canceling it.
+// Otherwise, the channel must be drained until it's closed.
+//
+// Paragraphs are separated the same as in UAX #9: by LF, CR, CR LF, the
+// information separators U+001C to U+001E, NEL (U+0085) or PARAGRAPH SEPARATOR
+// (U+2029), the characters of Bidi_Class B. ctx is checked between paragraphs,
+// and once one fails, neither the following paragraphs are read nor shaped.
+//
+// font is shared between the workers, so it must not be changed until the
+// channel is closed, see SharedFont.
+func ShapeDocument(ctx context.Context, font Font, r io.Reader, opts DocumentOptions) (<-chan ShapedParagraph, error) {
+	if font == nil {
+		return nil, ErrNilFont
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	type job struct {
+		paragraph ShapedParagraph
+		result    chan<- ShapedParagraph
+	}
+
+	// stop is canceled on the first failure, or with ctx.
+	stop, cancel := context.WithCancel(ctx)
+
+	jobs := make(chan job)
+	pending := make(chan chan ShapedParagraph, workers)
+	out := make(chan ShapedParagraph)
+
+	// The reader splits the document and hands the paragraphs to the workers,
+	// queueing their results in the document's order.
+	go func() {
+		defer close(jobs)
+		defer close(pending)
+
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(nil, math.MaxInt)
+		scanner.Split(scanParagraphs)
+
+		var index, offset int
+		for {
+			result := make(chan ShapedParagraph, 1)
+
+			var ok bool
+			if err := stop.Err(); err == nil {
+				ok = scanner.Scan()
+			}
+			if !ok {
+				err := stop.Err()
+				if err == nil {
+					err = scanner.Err()
+				}
+				if err != nil {
+					result <- ShapedParagraph{Index: index, Offset: offset, Err: err}
+					select {
+					case pending <- result:
+					case <-stop.Done():
+					}
+				}
+				return
+			}
+
+			text := trimParagraphSeparator(scanner.Text())
+			p := ShapedParagraph{Index: index, Offset: offset, Text: text}
+			index++
+			offset += len(scanner.Bytes())
+
+			select {
+			case pending <- result:
+			case <-stop.Done():
+				return
+			}
+			select {
+			case jobs <- job{paragraph: p, result: result}:
+			case <-stop.Done():
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			buffer := BufferCreate()
+			defer BufferDestroy(buffer)
+
+			for j := range jobs {
+				p := j.paragraph
+				if p.Err = stop.Err(); p.Err == nil {
+					BufferReset(buffer)
+					p.Run, p.Err = shapeTextWithBuffer(font, buffer, p.Text, &opts.Shape)
+				}
+
+				j.result <- p
+			}
+		}()
+	}
+
+	// The results are sent in order, stopping at the first error. Once stop is
+	// canceled, the reader stops queueing paragraphs and the workers stop
+	// shaping them, so returning right away leaves no goroutine blocked.
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		for result := range pending {
+			var p ShapedParagraph
+			select {
+			case p = <-result:
+			case <-stop.Done():
+				return
+			}
+
+			select {
+			case out <- p:
+			case <-stop.Done():
+				return
+			}
+			if p.Err != nil {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// scanParagraphs is a bufio.SplitFunc returning paragraphs along with their
+// separator, so that the offsets of the following paragraphs can be tracked.
+func scanParagraphs(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	for i := 0; i < len(data); {
+		r, size := utf8.DecodeRune(data[i:])
+		if r == utf8.RuneError && size == 1 && !atEOF && !utf8.FullRune(data[i:]) {
+			// The rest of the rune is yet to be read.
+			return 0, nil, nil
+		}
+
+		switch r {
+		case '\r':
+			if i+1 == len(data) && !atEOF {
+				// It may be followed by a LF.
+				return 0, nil, nil
+			}
+			if i+1 < len(data) && data[i+1] == '\n' {
+				size++
+			}
+			fallthrough
+		case '\n', '\u001c', '\u001d', '\u001e', '\u0085', '\u2029':
+			return i + size, data[:i+size], nil
+		}
+
+		i += size
+	}
+
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+
+	return 0, nil, nil
+}
+
+// trimParagraphSeparator trims the separator scanParagraphs leaves at the end
+// of a paragraph.
+func trimParagraphSeparator(text string) string {
+	r, size := utf8.DecodeLastRuneInString(text)
+	switch r {
+	case '\n':
+		text = text[:len(text)-size]
+		if len(text) > 0 && text[len(text)-1] == '\r' {
+			text = text[:len(text)-1]
+		}
+	case '\r', '\u001c', '\u001d', '\u001e', '\u0085', '\u2029':
+		text = text[:len(text)-size]
+	}
+
+	return text
+}
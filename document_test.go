@@ -0,0 +1,100 @@
+package hb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestShapeDocumentCancel(t *testing.T) {
+	font := testFont(t, "HB_TEST_FONT")
+	document := strings.Repeat("Hello, World!\n", 1000)
+
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	paragraphs, err := ShapeDocument(ctx, font, strings.NewReader(document), DocumentOptions{Workers: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p := <-paragraphs; p.Err != nil || p.Text != "Hello, World!" {
+		t.Fatalf("got paragraph %q with error %v, want %q", p.Text, p.Err, "Hello, World!")
+	}
+
+	// Stop receiving right after canceling, which must not leave any of the
+	// goroutines blocked.
+	cancel()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d goroutines after canceling, want %d", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestShapeDocument(t *testing.T) {
+	font := testFont(t, "HB_TEST_FONT")
+	document := "one\ntwo\r\nthree\rfour\u2029five\u001csix\u001dseven\u001eeight\u0085nine"
+	want := []string{"one", "two", "three", "four", "five", "six", "seven", "eight", "nine"}
+
+	paragraphs, err := ShapeDocument(context.Background(), font, strings.NewReader(document), DocumentOptions{Workers: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var i int
+	for p := range paragraphs {
+		if p.Err != nil {
+			t.Fatal(p.Err)
+		}
+		if i >= len(want) || p.Index != i || p.Text != want[i] {
+			t.Errorf("got paragraph %d %q", p.Index, p.Text)
+		} else if p.Offset != strings.Index(document, want[i]) {
+			t.Errorf("got offset %d for %q, want %d", p.Offset, p.Text, strings.Index(document, want[i]))
+		}
+		i++
+	}
+
+	if i != len(want) {
+		t.Errorf("got %d paragraphs, want %d", i, len(want))
+	}
+}
+
+func TestShapeDocumentReadError(t *testing.T) {
+	font := testFont(t, "HB_TEST_FONT")
+	errRead := errors.New("read failed")
+	r := io.MultiReader(strings.NewReader("one\ntwo\n"), &errorReader{err: errRead})
+
+	paragraphs, err := ShapeDocument(context.Background(), font, r, DocumentOptions{Workers: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []ShapedParagraph
+	for p := range paragraphs {
+		got = append(got, p)
+	}
+
+	if len(got) != 3 || got[0].Text != "one" || got[1].Text != "two" {
+		t.Fatalf("got %d paragraphs %v, want one, two and the error", len(got), got)
+	}
+	if !errors.Is(got[2].Err, errRead) || got[2].Index != 2 || got[2].Offset != 8 {
+		t.Errorf("got paragraph %d at %d with error %v, want 2 at 8 with %v", got[2].Index, got[2].Offset, got[2].Err, errRead)
+	}
+}
+
+// errorReader fails every read with err.
+type errorReader struct {
+	err error
+}
+
+func (r *errorReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,287 @@
+package hb
+
+import "sort"
+
+// FontRun is a run of text shaped with a single font by a FallbackShaper.
+type FontRun struct {
+	Font  Font // The font the run is shaped with.
+	Index int  // The index of Font in the FallbackShaper's fonts.
+	Start int  // The byte offset of the run in the text.
+	End   int  // The byte offset of the end of the run in the text.
+
+	// Run holds the glyphs of the run, in visual order. Their clusters are
+	// byte offsets in the whole text.
+	Run *ShapedRun
+}
+
+// FallbackShaper shapes text with a chain of fonts, falling back to the next
+// fonts for the characters the first one lacks. It's safe for concurrent use,
+// as long as its fonts aren't modified.
+type FallbackShaper struct {
+	fonts    []Font
+	coverage []Set
+}
+
+// NewFallbackShaper creates a FallbackShaper for fonts, in order of
+// preference, and collects the characters each of them covers. It returns
+// ErrNilFont if there's no font, or one of them is nil.
+//
+// The FallbackShaper holds a reference to the fonts until it's destroyed by
+// Destroy.
+func NewFallbackShaper(fonts []Font) (*FallbackShaper, error) {
+	if len(fonts) == 0 {
+		return nil, ErrNilFont
+	}
+	for _, font := range fonts {
+		if font == nil {
+			return nil, ErrNilFont
+		}
+	}
+
+	s := &FallbackShaper{
+		fonts:    make([]Font, len(fonts)),
+		coverage: make([]Set, len(fonts)),
+	}
+	for i, font := range fonts {
+		s.fonts[i] = FontReference(font)
+
+		s.coverage[i] = SetCreate()
+		FaceCollectUnicodes(FontGetFace(font), s.coverage[i])
+		if !SetAllocationSuccessful(s.coverage[i]) {
+			s.Destroy()
+			return nil, ErrAllocationFailed
+		}
+	}
+
+	return s, nil
+}
+
+// Fonts returns the fonts of the FallbackShaper, in order of preference. They
+// must not be modified.
+func (s *FallbackShaper) Fonts() []Font {
+	return s.fonts
+}
+
+// ShapeText shapes the UTF-8 encoded text with the first font, and returns the
+// runs of text shaped with each font, in logical order.
+//
+// The grapheme clusters with a missing glyph, which is .notdef (glyph 0) or
+// opts.NotFoundGlyph if set, are reshaped, with the rest of the text as
+// context, using the next font which covers all of their characters, ignoring
+// joiners, variation selectors and tags. Consecutive clusters falling back to
+// the same font are shaped together. The clusters no font covers are kept as
+// the first font shaped them. Every run is shaped with the segment properties
+// of the whole text.
+func (s *FallbackShaper) ShapeText(text string, opts ShapeOptions) ([]FontRun, error) {
+	if text == "" {
+		return nil, nil
+	}
+
+	buffer := BufferCreate()
+	defer BufferDestroy(buffer)
+
+	bufferSetupText(buffer, text, &opts)
+	Shape(s.fonts[0], buffer, opts.Features)
+	if !BufferAllocationSuccessful(buffer) {
+		return nil, ErrAllocationFailed
+	}
+
+	infos := BufferGetGlyphInfosView(buffer)
+	positions := BufferGetGlyphPositionsView(buffer)
+	props := BufferGetSegmentProperties(buffer)
+
+	// Split the text into units of whole grapheme clusters, which don't split
+	// the clusters of the first font either, so that its glyphs can be kept
+	// around the ones reshaped.
+	ends := clusterEnds(infos, len(text))
+	inside := make([]bool, len(text)+1)
+	for cluster, end := range ends {
+		for o := int(cluster) + 1; o < end; o++ {
+			inside[o] = true
+		}
+	}
+
+	var units []int
+	for _, offset := range graphemeStarts(text) {
+		if !inside[offset] {
+			units = append(units, offset)
+		}
+	}
+
+	// Pick a font for every unit with a missing glyph, 0 being the first font.
+	fonts := make([]int, len(units)-1)
+	missing := make([]bool, len(units)-1)
+	for _, info := range infos {
+		if info.Codepoint == opts.NotFoundGlyph {
+			missing[sort.SearchInts(units, int(info.Cluster)+1)-1] = true
+		}
+	}
+	for i := range fonts {
+		if missing[i] {
+			fonts[i] = s.fallbackFont(text[units[i]:units[i+1]])
+		}
+	}
+
+	type textRun struct {
+		start, end int
+		font       int
+	}
+	var runs []textRun
+	for i, font := range fonts {
+		if n := len(runs); n > 0 && runs[n-1].font == font {
+			runs[n-1].end = units[i+1]
+			continue
+		}
+		runs = append(runs, textRun{start: units[i], end: units[i+1], font: font})
+	}
+
+	var fontRuns []FontRun
+	for _, r := range runs {
+		var run *ShapedRun
+		if r.font == 0 {
+			run = sliceShapedRun(s.fonts[0], infos, positions, props, r.start, r.end, opts.Scale)
+		} else {
+			var err error
+			if run, err = s.shapeItem(r.font, text, r.start, r.end, props, &opts); err != nil {
+				return nil, err
+			}
+		}
+
+		fontRuns = append(fontRuns, FontRun{
+			Font:  s.fonts[r.font],
+			Index: r.font,
+			Start: r.start,
+			End:   r.end,
+			Run:   run,
+		})
+	}
+
+	return fontRuns, nil
+}
+
+// Destroy releases the font references and the coverage sets of the
+// FallbackShaper. It must not be used afterwards.
+func (s *FallbackShaper) Destroy() {
+	for i, font := range s.fonts {
+		if font != nil {
+			FontDestroy(font)
+		}
+		if s.coverage[i] != nil {
+			SetDestroy(s.coverage[i])
+		}
+	}
+}
+
+// fallbackFont returns the index of the first fallback font which covers the
+// characters of the grapheme cluster, or 0 if there's none.
+func (s *FallbackShaper) fallbackFont(grapheme string) int {
+next:
+	for i := 1; i < len(s.fonts); i++ {
+		for _, r := range grapheme {
+			if !isGraphemeFormat(r) && !SetHas(s.coverage[i], Codepoint(r)) {
+				continue next
+			}
+		}
+		return i
+	}
+
+	return 0
+}
+
+// shapeItem shapes the text in [start, end) with the font at index, with the
+// rest of the text as context.
+func (s *FallbackShaper) shapeItem(index int, text string, start, end int, props SegmentProperties, opts *ShapeOptions) (*ShapedRun, error) {
+	buffer := BufferCreate()
+	defer BufferDestroy(buffer)
+
+	BufferAddUTF8Item(buffer, text, start, end-start)
+	BufferSetSegmentProperties(buffer, props)
+	BufferSetClusterLevel(buffer, opts.ClusterLevel)
+	BufferSetNotFoundGlyph(buffer, opts.NotFoundGlyph)
+
+	flags := opts.Flags
+	if start > 0 {
+		flags &^= BufferFlagBot
+	}
+	if end < len(text) {
+		flags &^= BufferFlagEot
+	}
+	BufferSetFlags(buffer, flags)
+
+	Shape(s.fonts[index], buffer, opts.Features)
+	if !BufferAllocationSuccessful(buffer) {
+		return nil, ErrAllocationFailed
+	}
+
+	return newShapedRun(s.fonts[index], buffer, end, opts.Scale), nil
+}
+
+// sliceShapedRun builds a ShapedRun from the glyphs of font whose clusters are
+// in [start, end), keeping their visual order.
+func sliceShapedRun(font Font, infos []GlyphInfo, positions []GlyphPosition, props SegmentProperties, start, end int, scale float32) *ShapedRun {
+	var sliceInfos []GlyphInfo
+	var slicePositions []GlyphPosition
+	for i, info := range infos {
+		if cluster := int(info.Cluster); cluster >= start && cluster < end {
+			sliceInfos = append(sliceInfos, info)
+			slicePositions = append(slicePositions, positions[i])
+		}
+	}
+
+	glyphExtents := func(i int, glyph Codepoint) (GlyphExtents, bool) {
+		return FontGetGlyphExtents(font, glyph)
+	}
+
+	return buildShapedRun(sliceInfos, slicePositions, props, end, scale, glyphExtents)
+}
+
+// graphemeStarts returns the byte offsets of the grapheme clusters of text,
+// followed by its length. As HarfBuzz does, a cluster is a base character
+// followed by marks, joiners, variation selectors, emoji modifiers and tags,
+// and the character after a zero width joiner.
+func graphemeStarts(text string) []int {
+	ufuncs := UnicodeFuncsGetDefault()
+
+	starts := []int{0}
+	joined := false
+	for offset, r := range text {
+		if offset == 0 {
+			joined = r == 0x200D
+			continue
+		}
+
+		continuation := joined || isGraphemeFormat(r) || r >= 0x1F3FB && r <= 0x1F3FF
+		if !continuation {
+			switch UnicodeGeneralCategoryOf(ufuncs, Codepoint(r)) {
+			case UnicodeGeneralCategoryNonSpacingMark, UnicodeGeneralCategorySpacingMark, UnicodeGeneralCategoryEnclosingMark:
+				continuation = true
+			}
+		}
+
+		if !continuation {
+			starts = append(starts, offset)
+		}
+		joined = r == 0x200D
+	}
+
+	if len(text) > 0 {
+		starts = append(starts, len(text))
+	}
+
+	return starts
+}
+
+// isGraphemeFormat reports whether r is a joiner, a variation selector or a
+// tag, which fonts don't need to cover.
+func isGraphemeFormat(r rune) bool {
+	switch {
+	case r == 0x200C, r == 0x200D:
+		return true
+	case r >= 0xFE00 && r <= 0xFE0F, r >= 0xE0100 && r <= 0xE01EF:
+		return true
+	case r >= 0xE0020 && r <= 0xE007F:
+		return true
+	}
+
+	return false
+}
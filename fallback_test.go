@@ -0,0 +1,157 @@
+package hb
+
+import "testing"
+
+func TestGraphemeStarts(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []int
+	}{
+		{"letters", "ab", []int{0, 1, 2}},
+		{"mark", "e\u0301x", []int{0, 3, 4}},
+		{"zwj sequence", "\U0001F468\u200D\U0001F469a", []int{0, 11, 12}},
+		{"emoji modifier", "\U0001F44D\U0001F3FDa", []int{0, 8, 9}},
+		{"tags", "\U0001F3F4\U000E0067\U000E0062\U000E007Fa", []int{0, 16, 17}},
+		{"variation selector", "\u263A\uFE0Fa", []int{0, 6, 7}},
+		{"leading zwj", "\u200Dab", []int{0, 4, 5}},
+	}
+
+	for _, test := range tests {
+		if got := graphemeStarts(test.text); !equalInts(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFallbackFont(t *testing.T) {
+	coverage := func(text string) Set {
+		set := SetCreate()
+		for _, r := range text {
+			SetAdd(set, Codepoint(r))
+		}
+		t.Cleanup(func() { SetDestroy(set) })
+		return set
+	}
+
+	// The fonts aren't needed to pick one.
+	s := &FallbackShaper{
+		fonts:    make([]Font, 3),
+		coverage: []Set{coverage("a"), coverage("ab"), coverage("abc\u0301")},
+	}
+
+	tests := []struct {
+		grapheme string
+		want     int
+	}{
+		{"b", 1},
+		{"c", 2},
+		{"b\u0301", 2},
+		{"x", 0},
+		{"b\uFE0F", 1},
+		{"b\u200D", 1},
+	}
+
+	for _, test := range tests {
+		if got := s.fallbackFont(test.grapheme); got != test.want {
+			t.Errorf("fallbackFont(%q): got %d, want %d", test.grapheme, got, test.want)
+		}
+	}
+}
+
+func TestFallbackShaper(t *testing.T) {
+	primary := buildTestFont(t, testFontSpec{runes: "abc אג"})
+	fallback := buildTestFont(t, testFontSpec{runes: "xyz\u0301ב"})
+
+	s, err := NewFallbackShaper([]Font{primary, fallback})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Destroy()
+
+	type wantRun struct {
+		start, end int
+		index      int
+		glyphs     []Codepoint // The glyphs, in visual order.
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want []wantRun
+	}{
+		{
+			name: "merged units",
+			text: "ab xy c",
+			want: []wantRun{
+				{0, 3, 0, []Codepoint{1, 2, 4}},
+				{3, 5, 1, []Codepoint{1, 2}},
+				{5, 7, 0, []Codepoint{4, 3}},
+			},
+		},
+		{
+			name: "uncovered",
+			text: "aqb",
+			want: []wantRun{{0, 3, 0, []Codepoint{1, 0, 2}}},
+		},
+		{
+			name: "grapheme",
+			text: "ax\u0301",
+			want: []wantRun{
+				{0, 1, 0, []Codepoint{1}},
+				{1, 4, 1, []Codepoint{1, 4}},
+			},
+		},
+		{
+			name: "rtl",
+			text: "אגב",
+			want: []wantRun{
+				{0, 4, 0, []Codepoint{6, 5}},
+				{4, 6, 1, []Codepoint{5}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		for _, notFound := range []Codepoint{0, 99} {
+			runs, err := s.ShapeText(test.text, ShapeOptions{NotFoundGlyph: notFound})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(runs) != len(test.want) {
+				t.Errorf("%s, not found glyph %d: got %d runs, want %d", test.name, notFound, len(runs), len(test.want))
+				continue
+			}
+
+			for i, want := range test.want {
+				run := runs[i]
+
+				var glyphs []Codepoint
+				for _, glyph := range run.Run.Glyphs {
+					if glyph.ID == notFound {
+						glyph.ID = 0
+					}
+					glyphs = append(glyphs, glyph.ID)
+				}
+
+				if run.Start != want.start || run.End != want.end || run.Index != want.index || !equalCodepoints(glyphs, want.glyphs) {
+					t.Errorf("%s, not found glyph %d: got run [%d, %d) of font %d with glyphs %v; want [%d, %d) of font %d with glyphs %v",
+						test.name, notFound, run.Start, run.End, run.Index, glyphs, want.start, want.end, want.index, want.glyphs)
+				}
+			}
+		}
+	}
+}
+
+func equalCodepoints(a, b []Codepoint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,280 @@
+//go:build ignore
+
+// gen_tables generates bidi_table.go and linebreak_table.go from the Unicode
+// Character Database. Run it with go generate, or directly:
+//
+//	go run gen_tables.go [-version 15.0.0] [-ucd dir] [-table bidi|linebreak]
+//
+// The UCD files are downloaded from unicode.org, unless -ucd names a local
+// directory laid out the same as https://www.unicode.org/Public/<version>/ucd/.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	version = flag.String("version", "15.0.0", "the UCD version to generate the tables from")
+	ucd     = flag.String("ucd", "", "a local UCD directory to read the files from instead of unicode.org")
+	table   = flag.String("table", "", "the table to generate, bidi or linebreak; both if empty")
+)
+
+// A ucdTable is a table generated from a single UCD property file.
+type ucdTable struct {
+	name     string // The name passed to -table.
+	file     string // The path of the property file in the UCD.
+	output   string // The generated Go file.
+	variable string // The Go variable holding the ranges.
+	rangeTyp string // The Go type of the ranges.
+	prefix   string // The prefix of the Go class constants.
+	property string // The name of the property, for the doc comment.
+	fallback string // The class of the code points not in any range.
+
+	// longNames maps the long names of the property values, which @missing
+	// lines may use, to their short names.
+	longNames map[string]string
+}
+
+var tables = []ucdTable{
+	{
+		name:     "bidi",
+		file:     "extracted/DerivedBidiClass.txt",
+		output:   "bidi_table.go",
+		variable: "bidiClasses",
+		rangeTyp: "bidiClassRange",
+		prefix:   "Bidi",
+		property: "Bidi_Class",
+		fallback: "L",
+		longNames: map[string]string{
+			"Left_To_Right":           "L",
+			"Right_To_Left":           "R",
+			"Arabic_Letter":           "AL",
+			"European_Number":         "EN",
+			"European_Separator":      "ES",
+			"European_Terminator":     "ET",
+			"Arabic_Number":           "AN",
+			"Common_Separator":        "CS",
+			"Nonspacing_Mark":         "NSM",
+			"Boundary_Neutral":        "BN",
+			"Paragraph_Separator":     "B",
+			"Segment_Separator":       "S",
+			"White_Space":             "WS",
+			"Other_Neutral":           "ON",
+			"Left_To_Right_Embedding": "LRE",
+			"Left_To_Right_Override":  "LRO",
+			"Right_To_Left_Embedding": "RLE",
+			"Right_To_Left_Override":  "RLO",
+			"Pop_Directional_Format":  "PDF",
+			"Left_To_Right_Isolate":   "LRI",
+			"Right_To_Left_Isolate":   "RLI",
+			"First_Strong_Isolate":    "FSI",
+			"Pop_Directional_Isolate": "PDI",
+		},
+	},
+	{
+		name:     "linebreak",
+		file:     "LineBreak.txt",
+		output:   "linebreak_table.go",
+		variable: "lineBreakClasses",
+		rangeTyp: "lineBreakClassRange",
+		prefix:   "LineBreak",
+		property: "Line_Break",
+		fallback: "XX",
+		longNames: map[string]string{
+			"Unknown":                      "XX",
+			"Ideographic":                  "ID",
+			"Prefix_Numeric":               "PR",
+			"Complex_Context":              "SA",
+			"Alphabetic":                   "AL",
+			"Ambiguous":                    "AI",
+			"Conditional_Japanese_Starter": "CJ",
+		},
+	},
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen_tables: ")
+	flag.Parse()
+
+	var found bool
+	for _, t := range tables {
+		if *table != "" && *table != t.name {
+			continue
+		}
+		found = true
+
+		if err := generate(t); err != nil {
+			log.Fatalf("%s: %v", t.output, err)
+		}
+	}
+
+	if !found {
+		log.Fatalf("unknown table %q", *table)
+	}
+}
+
+func generate(t ucdTable) error {
+	data, err := openUCD(t.file)
+	if err != nil {
+		return err
+	}
+
+	classes, err := parseProperty(data, t)
+	if err != nil {
+		return err
+	}
+
+	src, err := format.Source(writeTable(t, classes))
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(t.output, src, 0o644)
+}
+
+// openUCD returns the content of the UCD file at path.
+func openUCD(path string) ([]byte, error) {
+	if *ucd != "" {
+		return os.ReadFile(filepath.Join(*ucd, filepath.FromSlash(path)))
+	}
+
+	url := "https://www.unicode.org/Public/" + *version + "/ucd/" + path
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// parseProperty returns the class of every code point, as the short name of
+// its property value, from a UCD property file. The @missing lines set the
+// default values, each overriding the ones before it, and the data lines
+// override those.
+func parseProperty(data []byte, t ucdTable) ([]string, error) {
+	classes := make([]string, 0x110000)
+	for i := range classes {
+		classes[i] = t.fallback
+	}
+
+	if !bytes.Contains(data, []byte("-"+*version+".txt")) {
+		return nil, fmt.Errorf("%s is not of version %s", t.file, *version)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+
+		if missing, ok := strings.CutPrefix(text, "# @missing:"); ok {
+			text = missing
+		} else if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+
+		codepoints, value, ok := strings.Cut(text, ";")
+		if !ok {
+			if strings.TrimSpace(text) != "" {
+				return nil, fmt.Errorf("%s:%d: malformed line", t.file, line)
+			}
+			continue
+		}
+
+		lo, hi, err := parseRange(strings.TrimSpace(codepoints))
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", t.file, line, err)
+		}
+
+		value = strings.TrimSpace(value)
+		if short, ok := t.longNames[value]; ok {
+			value = short
+		}
+
+		for r := lo; r <= hi; r++ {
+			classes[r] = value
+		}
+	}
+
+	return classes, scanner.Err()
+}
+
+// parseRange parses a code point, or a range of them such as 0000..001F.
+func parseRange(s string) (lo, hi int, err error) {
+	loText, hiText, ok := strings.Cut(s, "..")
+	if !ok {
+		hiText = loText
+	}
+
+	lo64, err := strconv.ParseUint(loText, 16, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	hi64, err := strconv.ParseUint(hiText, 16, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if lo64 > hi64 || hi64 > 0x10FFFF {
+		return 0, 0, fmt.Errorf("invalid range %s", s)
+	}
+
+	return int(lo64), int(hi64), nil
+}
+
+// writeTable writes the Go source of the table, as the sorted ranges of the
+// code points with the same class, leaving out the fallback class.
+func writeTable(t ucdTable, classes []string) []byte {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "// Code generated by gen_tables.go. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "//go:generate go run gen_tables.go -version %s -table %s\n\n", *version, t.name)
+	fmt.Fprintf(&b, "package hb\n\n")
+	writeComment(&b, fmt.Sprintf("%s holds the %s property of the code points, derived from the Unicode Character Database version %s, as sorted ranges. Code points not in any of the ranges are %s%s.", t.variable, t.property, *version, t.prefix, t.fallback))
+	fmt.Fprintf(&b, "var %s = [...]%s{\n", t.variable, t.rangeTyp)
+
+	for lo := 0; lo < len(classes); {
+		hi := lo
+		for hi+1 < len(classes) && classes[hi+1] == classes[lo] {
+			hi++
+		}
+
+		if classes[lo] != t.fallback {
+			fmt.Fprintf(&b, "{0x%04X, 0x%04X, %s%s},\n", lo, hi, t.prefix, classes[lo])
+		}
+
+		lo = hi + 1
+	}
+
+	fmt.Fprintf(&b, "}\n")
+
+	return b.Bytes()
+}
+
+// writeComment writes text as a line comment, wrapped at 80 columns.
+func writeComment(b *bytes.Buffer, text string) {
+	line := "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > 80 {
+			b.WriteString(line + "\n")
+			line = "//"
+		}
+		line += " " + word
+	}
+	b.WriteString(line + "\n")
+}
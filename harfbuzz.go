@@ -3,8 +3,14 @@ package hb // import hb "github.com/haashemi/go-harfbuzz"
 // #cgo pkg-config: harfbuzz
 // #include <stdlib.h>
 // #include <hb.h>
+//
+// extern void goDestroyHandle(void *);
 import "C"
-import "unsafe"
+
+import (
+	"runtime/cgo"
+	"unsafe"
+)
 
 func cBool(b bool) C.int {
 	if b {
@@ -21,6 +27,18 @@ func cFeatures(features []Feature) *C.hb_feature_t {
 	return (*C.hb_feature_t)(unsafe.Pointer(&features[0]))
 }
 
+// cString returns a pointer to the bytes of str, without copying it. The
+// result is not NUL-terminated, so it must be passed along with len(str), and
+// must not be retained by C after the call returns.
+func cString(str string) *C.char {
+	return (*C.char)(unsafe.Pointer(unsafe.StringData(str)))
+}
+
+// cBytes is like cString, but for byte slices.
+func cBytes(b []byte) *C.char {
+	return (*C.char)(unsafe.Pointer(unsafe.SliceData(b)))
+}
+
 func cStringArray(items []string) []*C.char {
 	if items == nil {
 		return nil
@@ -36,8 +54,41 @@ func cStringArray(items []string) []*C.char {
 	return arr
 }
 
+// cStringArrayPtr returns a pointer to the first item of an array created by
+// cStringArray, or nil if it's nil.
+func cStringArrayPtr(arr []*C.char) **C.char {
+	if len(arr) == 0 {
+		return nil
+	}
+
+	return &arr[0]
+}
+
 func freeStringArray(items []*C.char) {
 	for _, item := range items {
 		C.free(unsafe.Pointer(item))
 	}
 }
+
+// cHandle stores a handle to v in C memory, so it can be passed to HarfBuzz as
+// callback user data. It's released by cDestroyHandle once HarfBuzz is done.
+func cHandle(v any) unsafe.Pointer {
+	p := C.malloc(C.size_t(unsafe.Sizeof(cgo.Handle(0))))
+	*(*cgo.Handle)(p) = cgo.NewHandle(v)
+
+	return p
+}
+
+// handleValue returns the value stored by cHandle.
+func handleValue(p unsafe.Pointer) any {
+	return (*(*cgo.Handle)(p)).Value()
+}
+
+// cDestroyHandle is the destroy callback for user data created by cHandle.
+var cDestroyHandle = C.hb_destroy_func_t(C.goDestroyHandle)
+
+//export goDestroyHandle
+func goDestroyHandle(p unsafe.Pointer) {
+	(*(*cgo.Handle)(p)).Delete()
+	C.free(p)
+}
@@ -0,0 +1,270 @@
+package hb
+
+import (
+	"encoding/binary"
+	"os"
+	"sort"
+	"testing"
+)
+
+// testFont returns a font loaded from the file named by the environment
+// variable env, or a font of the empty face if it's unset. The empty face maps
+// every character to .notdef, which is still enough to exercise the shaping
+// machinery; point env at a real font to test actual glyphs.
+//
+// The font is destroyed when the test finishes.
+func testFont(tb testing.TB, env string) Font {
+	tb.Helper()
+
+	face := FaceGetEmpty()
+	if path := os.Getenv(env); path != "" {
+		blob := BlobCreateFromFileOrFail(path)
+		if blob == nil {
+			tb.Fatalf("failed to load %s from %q", env, path)
+		}
+		defer BlobDestroy(blob)
+
+		face = FaceCreate(blob, 0)
+		defer FaceDestroy(face)
+	}
+
+	font := FontCreate(face)
+	tb.Cleanup(func() { FontDestroy(font) })
+
+	return font
+}
+
+// testFontSpec describes a font built by buildTestFont. Glyph 0 is .notdef,
+// followed by a glyph for every character of runes, in order, and then by a
+// glyph for every ligature. Every glyph advances by 100 units, of 1000 per em,
+// unless set otherwise in advances.
+type testFontSpec struct {
+	runes    string
+	advances map[rune]uint16
+
+	ligatures      []string        // Texts turned into a single glyph by the ccmp feature.
+	decompositions map[rune]string // Characters turned into the glyphs of a text by the ccmp feature.
+	kerns          []testKern      // Pairs kerned by the kern feature.
+}
+
+// testKern adds value to the advance of the glyph of second when it follows
+// the glyph of first.
+type testKern struct {
+	first, second rune
+	value         int16
+}
+
+// buildTestFont builds a minimal OpenType font following spec, with just the
+// tables HarfBuzz needs to shape with it, so that tests don't depend on the
+// fonts installed. The font is destroyed when the test finishes.
+func buildTestFont(tb testing.TB, spec testFontSpec) Font {
+	tb.Helper()
+
+	runes := []rune(spec.runes)
+	glyphs := make(map[rune]uint16, len(runes))
+	for i, r := range runes {
+		glyphs[r] = uint16(i + 1)
+	}
+	glyph := func(r rune) uint16 {
+		g, ok := glyphs[r]
+		if !ok {
+			tb.Fatalf("no glyph for %U in the test font", r)
+		}
+		return g
+	}
+	numGlyphs := len(runes) + len(spec.ligatures) + 1
+
+	tables := TableMap{}
+
+	head := make([]byte, 54)
+	binary.BigEndian.PutUint32(head[0:], 0x00010000)
+	binary.BigEndian.PutUint32(head[12:], 0x5F0F3CF5)
+	binary.BigEndian.PutUint16(head[18:], 1000)
+	tables[TagFromString("head")] = head
+
+	tables[TagFromString("maxp")] = otUint16s(otUint32s(nil, 0x00005000), numGlyphs)
+
+	hhea := make([]byte, 36)
+	binary.BigEndian.PutUint32(hhea[0:], 0x00010000)
+	binary.BigEndian.PutUint16(hhea[4:], 800)
+	binary.BigEndian.PutUint16(hhea[6:], uint16(0x10000-200))
+	binary.BigEndian.PutUint16(hhea[18:], 1)
+	binary.BigEndian.PutUint16(hhea[34:], uint16(numGlyphs))
+	tables[TagFromString("hhea")] = hhea
+
+	var hmtx []byte
+	for g := 0; g < numGlyphs; g++ {
+		advance := uint16(100)
+		if g >= 1 && g <= len(runes) {
+			if a, ok := spec.advances[runes[g-1]]; ok {
+				advance = a
+			}
+		}
+		hmtx = otUint16s(hmtx, int(advance), 0)
+	}
+	tables[TagFromString("hmtx")] = hmtx
+
+	// A format 12 cmap subtable, with a group for every character.
+	sorted := append([]rune(nil), runes...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	cmap := otUint16s(nil, 0, 1, 3, 10)
+	cmap = otUint32s(cmap, 12)
+	cmap = otUint16s(cmap, 12, 0)
+	cmap = otUint32s(cmap, uint32(16+12*len(sorted)), 0, uint32(len(sorted)))
+	for _, r := range sorted {
+		cmap = otUint32s(cmap, uint32(r), uint32(r), uint32(glyphs[r]))
+	}
+	tables[TagFromString("cmap")] = cmap
+
+	var substitutions [][]byte
+	if len(spec.ligatures) > 0 {
+		ligatures := map[uint16][][]uint16{}
+		for i, text := range spec.ligatures {
+			var components []uint16
+			for _, r := range text {
+				components = append(components, glyph(r))
+			}
+			ligature := append([]uint16{uint16(len(runes) + 1 + i)}, components...)
+			ligatures[components[0]] = append(ligatures[components[0]], ligature)
+		}
+
+		substitutions = append(substitutions, otLookup(4, otSubtable(ligatures, nil, func(ligatures [][]uint16) []byte {
+			set := otUint16s(nil, len(ligatures))
+			var data []byte
+			for _, ligature := range ligatures {
+				set = otUint16s(set, 2+2*len(ligatures)+len(data))
+				data = otUint16s(data, int(ligature[0]), len(ligature)-1)
+				for _, component := range ligature[2:] {
+					data = otUint16s(data, int(component))
+				}
+			}
+			return append(set, data...)
+		})))
+	}
+	if len(spec.decompositions) > 0 {
+		sequences := map[uint16][][]uint16{}
+		for r, text := range spec.decompositions {
+			var sequence []uint16
+			for _, c := range text {
+				sequence = append(sequence, glyph(c))
+			}
+			sequences[glyph(r)] = [][]uint16{sequence}
+		}
+
+		substitutions = append(substitutions, otLookup(2, otSubtable(sequences, nil, func(sequences [][]uint16) []byte {
+			sequence := otUint16s(nil, len(sequences[0]))
+			for _, g := range sequences[0] {
+				sequence = otUint16s(sequence, int(g))
+			}
+			return sequence
+		})))
+	}
+	if len(substitutions) > 0 {
+		tables[TagFromString("GSUB")] = otLayoutTable("ccmp", substitutions)
+	}
+
+	if len(spec.kerns) > 0 {
+		pairs := map[uint16][][]uint16{}
+		for _, kern := range spec.kerns {
+			first := glyph(kern.first)
+			pairs[first] = append(pairs[first], []uint16{glyph(kern.second), uint16(kern.value)})
+		}
+
+		// A pair adjustment subtable, with no value for the first glyph and
+		// the X advance for the second one.
+		subtable := otSubtable(pairs, []int{0, 0x0004}, func(pairs [][]uint16) []byte {
+			sort.Slice(pairs, func(i, j int) bool { return pairs[i][0] < pairs[j][0] })
+			set := otUint16s(nil, len(pairs))
+			for _, pair := range pairs {
+				set = otUint16s(set, int(pair[0]), int(pair[1]))
+			}
+			return set
+		})
+		tables[TagFromString("GPOS")] = otLayoutTable("kern", [][]byte{otLookup(2, subtable)})
+	}
+
+	face := FaceCreateForTables(tables)
+	defer FaceDestroy(face)
+
+	font := FontCreate(face)
+	tb.Cleanup(func() { FontDestroy(font) })
+
+	return font
+}
+
+// otUint16s appends values to b as big-endian 16-bit integers.
+func otUint16s(b []byte, values ...int) []byte {
+	for _, v := range values {
+		b = binary.BigEndian.AppendUint16(b, uint16(v))
+	}
+	return b
+}
+
+// otUint32s appends values to b as big-endian 32-bit integers.
+func otUint32s(b []byte, values ...uint32) []byte {
+	for _, v := range values {
+		b = binary.BigEndian.AppendUint32(b, v)
+	}
+	return b
+}
+
+// otSubtable builds a lookup subtable of format 1, made of a coverage of the
+// first glyphs of items, the fields following its offset, and a table built by
+// table for the items of every glyph, in the order of the coverage.
+func otSubtable(items map[uint16][][]uint16, fields []int, table func(items [][]uint16) []byte) []byte {
+	var firsts []uint16
+	for first := range items {
+		firsts = append(firsts, first)
+	}
+	sort.Slice(firsts, func(i, j int) bool { return firsts[i] < firsts[j] })
+
+	coverage := otUint16s(nil, 1, len(firsts))
+	for _, first := range firsts {
+		coverage = otUint16s(coverage, int(first))
+	}
+
+	headerSize := 6 + 2*len(fields) + 2*len(firsts)
+	header := otUint16s(nil, 1, headerSize)
+	header = otUint16s(header, fields...)
+	header = otUint16s(header, len(firsts))
+	data := coverage
+	for _, first := range firsts {
+		header = otUint16s(header, headerSize+len(data))
+		data = append(data, table(items[first])...)
+	}
+
+	return append(header, data...)
+}
+
+// otLookup builds a lookup of kind, with a single subtable.
+func otLookup(kind int, subtable []byte) []byte {
+	return append(otUint16s(nil, kind, 0, 1, 8), subtable...)
+}
+
+// otLayoutTable builds a GSUB or GPOS table, with lookups under a single
+// feature of the default script.
+func otLayoutTable(feature string, lookups [][]byte) []byte {
+	scripts := otUint16s(append(otUint16s(nil, 1), "DFLT"...), 8)
+	scripts = otUint16s(scripts, 4, 0, 0, 0xFFFF, 1, 0)
+
+	features := otUint16s(append(otUint16s(nil, 1), feature...), 8)
+	features = otUint16s(features, 0, len(lookups))
+	for i := range lookups {
+		features = otUint16s(features, i)
+	}
+
+	lookupList := otUint16s(nil, len(lookups))
+	offset := 2 + 2*len(lookups)
+	for _, lookup := range lookups {
+		lookupList = otUint16s(lookupList, offset)
+		offset += len(lookup)
+	}
+	for _, lookup := range lookups {
+		lookupList = append(lookupList, lookup...)
+	}
+
+	table := otUint16s(nil, 1, 0, 10, 10+len(scripts), 10+len(scripts)+len(features))
+	table = append(table, scripts...)
+	table = append(table, features...)
+	return append(table, lookupList...)
+}
@@ -0,0 +1,640 @@
+package hb
+
+// #include <hb.h>
+// #include <hb-aat.h>
+import "C"
+import "unsafe"
+
+// OTNameID is an identifier for a name table entry. It's used by AAT feature
+// types and selectors to point at their human-readable names.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-ot-name.html#hb-ot-name-id-t
+type OTNameID C.hb_ot_name_id_t
+
+// AATLayoutNoSelectorIndex is used as the default index of feature types which
+// do not have a default selector (non-exclusive feature types).
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-aat-layout.html#HB-AAT-LAYOUT-NO-SELECTOR-INDEX:CAPS
+const AATLayoutNoSelectorIndex = C.HB_AAT_LAYOUT_NO_SELECTOR_INDEX
+
+// AATLayoutFeatureType is the possible feature types defined for AAT shaping,
+// from Apple's Font Feature Registry.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-aat-layout.html#hb-aat-layout-feature-type-t
+type AATLayoutFeatureType C.hb_aat_layout_feature_type_t
+
+const (
+	AATLayoutFeatureTypeInvalid                       AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_INVALID                           // Initial, unset feature type.
+	AATLayoutFeatureTypeAllTypographic                AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_ALL_TYPOGRAPHIC                   // All typographic features.
+	AATLayoutFeatureTypeLigatures                     AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_LIGATURES                         // Ligatures.
+	AATLayoutFeatureTypeCursiveConnection             AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_CURISVE_CONNECTION                // Cursive connection.
+	AATLayoutFeatureTypeLetterCase                    AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_LETTER_CASE                       // Letter case.
+	AATLayoutFeatureTypeVerticalSubstitution          AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_VERTICAL_SUBSTITUTION             // Vertical substitution.
+	AATLayoutFeatureTypeLinguisticRearrangement       AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_LINGUISTIC_REARRANGEMENT          // Linguistic rearrangement.
+	AATLayoutFeatureTypeNumberSpacing                 AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_NUMBER_SPACING                    // Number spacing.
+	AATLayoutFeatureTypeSmartSwashType                AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_SMART_SWASH_TYPE                  // Smart swash.
+	AATLayoutFeatureTypeDiacriticsType                AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_DIACRITICS_TYPE                   // Diacritics.
+	AATLayoutFeatureTypeVerticalPosition              AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_VERTICAL_POSITION                 // Vertical position.
+	AATLayoutFeatureTypeFractions                     AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_FRACTIONS                         // Fractions.
+	AATLayoutFeatureTypeOverlappingCharactersType     AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_OVERLAPPING_CHARACTERS_TYPE       // Overlapping characters.
+	AATLayoutFeatureTypeTypographicExtras             AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_TYPOGRAPHIC_EXTRAS                // Typographic extras.
+	AATLayoutFeatureTypeMathematicalExtras            AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_MATHEMATICAL_EXTRAS               // Mathematical extras.
+	AATLayoutFeatureTypeOrnamentSetsType              AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_ORNAMENT_SETS_TYPE                // Ornament sets.
+	AATLayoutFeatureTypeCharacterAlternatives         AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_CHARACTER_ALTERNATIVES            // Character alternatives.
+	AATLayoutFeatureTypeDesignComplexityType          AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_DESIGN_COMPLEXITY_TYPE            // Design complexity.
+	AATLayoutFeatureTypeStyleOptions                  AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_STYLE_OPTIONS                     // Style options.
+	AATLayoutFeatureTypeCharacterShape                AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_CHARACTER_SHAPE                   // Character shape.
+	AATLayoutFeatureTypeNumberCase                    AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_NUMBER_CASE                       // Number case.
+	AATLayoutFeatureTypeTextSpacing                   AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_TEXT_SPACING                      // Text spacing.
+	AATLayoutFeatureTypeTransliteration               AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_TRANSLITERATION                   // Transliteration.
+	AATLayoutFeatureTypeAnnotationType                AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_ANNOTATION_TYPE                   // Annotation.
+	AATLayoutFeatureTypeKanaSpacingType               AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_KANA_SPACING_TYPE                 // Kana spacing.
+	AATLayoutFeatureTypeIdeographicSpacingType        AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_IDEOGRAPHIC_SPACING_TYPE          // Ideographic spacing.
+	AATLayoutFeatureTypeUnicodeDecompositionType      AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_UNICODE_DECOMPOSITION_TYPE        // Unicode decomposition.
+	AATLayoutFeatureTypeRubyKana                      AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_RUBY_KANA                         // Ruby kana.
+	AATLayoutFeatureTypeCJKSymbolAlternativesType     AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_CJK_SYMBOL_ALTERNATIVES_TYPE      // CJK symbol alternatives.
+	AATLayoutFeatureTypeIdeographicAlternativesType   AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_IDEOGRAPHIC_ALTERNATIVES_TYPE     // Ideographic alternatives.
+	AATLayoutFeatureTypeCJKVerticalRomanPlacementType AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_CJK_VERTICAL_ROMAN_PLACEMENT_TYPE // CJK vertical roman placement.
+	AATLayoutFeatureTypeItalicCJKRoman                AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_ITALIC_CJK_ROMAN                  // Italic CJK roman.
+	AATLayoutFeatureTypeCaseSensitiveLayout           AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_CASE_SENSITIVE_LAYOUT             // Case sensitive layout.
+	AATLayoutFeatureTypeAlternateKana                 AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_ALTERNATE_KANA                    // Alternate kana.
+	AATLayoutFeatureTypeStylisticAlternatives         AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_STYLISTIC_ALTERNATIVES            // Stylistic alternatives.
+	AATLayoutFeatureTypeContextualAlternatives        AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_CONTEXTUAL_ALTERNATIVES           // Contextual alternatives.
+	AATLayoutFeatureTypeLowerCase                     AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_LOWER_CASE                        // Lower case.
+	AATLayoutFeatureTypeUpperCase                     AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_UPPER_CASE                        // Upper case.
+	AATLayoutFeatureTypeLanguageTagType               AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_LANGUAGE_TAG_TYPE                 // Language tag.
+	AATLayoutFeatureTypeCJKRomanSpacingType           AATLayoutFeatureType = C.HB_AAT_LAYOUT_FEATURE_TYPE_CJK_ROMAN_SPACING_TYPE            // CJK roman spacing.
+)
+
+// AATLayoutFeatureSelector is the selectors defined for specifying AAT feature
+// settings. Selector values are only meaningful together with the
+// AATLayoutFeatureType they belong to.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-aat-layout.html#hb-aat-layout-feature-selector-t
+type AATLayoutFeatureSelector C.hb_aat_layout_feature_selector_t
+
+const (
+	AATLayoutFeatureSelectorInvalid AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_INVALID // Initial, unset feature selector.
+
+	// AATLayoutFeatureTypeAllTypographic
+	AATLayoutFeatureSelectorAllTypeFeaturesOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ALL_TYPE_FEATURES_ON
+	AATLayoutFeatureSelectorAllTypeFeaturesOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ALL_TYPE_FEATURES_OFF
+
+	// AATLayoutFeatureTypeLigatures
+	AATLayoutFeatureSelectorRequiredLigaturesOn       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_REQUIRED_LIGATURES_ON
+	AATLayoutFeatureSelectorRequiredLigaturesOff      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_REQUIRED_LIGATURES_OFF
+	AATLayoutFeatureSelectorCommonLigaturesOn         AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_COMMON_LIGATURES_ON
+	AATLayoutFeatureSelectorCommonLigaturesOff        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_COMMON_LIGATURES_OFF
+	AATLayoutFeatureSelectorRareLigaturesOn           AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_RARE_LIGATURES_ON
+	AATLayoutFeatureSelectorRareLigaturesOff          AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_RARE_LIGATURES_OFF
+	AATLayoutFeatureSelectorLogosOn                   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_LOGOS_ON
+	AATLayoutFeatureSelectorLogosOff                  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_LOGOS_OFF
+	AATLayoutFeatureSelectorRebusPicturesOn           AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_REBUS_PICTURES_ON
+	AATLayoutFeatureSelectorRebusPicturesOff          AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_REBUS_PICTURES_OFF
+	AATLayoutFeatureSelectorDiphthongLigaturesOn      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DIPHTHONG_LIGATURES_ON
+	AATLayoutFeatureSelectorDiphthongLigaturesOff     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DIPHTHONG_LIGATURES_OFF
+	AATLayoutFeatureSelectorSquaredLigaturesOn        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SQUARED_LIGATURES_ON
+	AATLayoutFeatureSelectorSquaredLigaturesOff       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SQUARED_LIGATURES_OFF
+	AATLayoutFeatureSelectorAbbrevSquaredLigaturesOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ABBREV_SQUARED_LIGATURES_ON
+	AATLayoutFeatureSelectorAbbrevSquaredLigaturesOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ABBREV_SQUARED_LIGATURES_OFF
+	AATLayoutFeatureSelectorSymbolLigaturesOn         AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SYMBOL_LIGATURES_ON
+	AATLayoutFeatureSelectorSymbolLigaturesOff        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SYMBOL_LIGATURES_OFF
+	AATLayoutFeatureSelectorContextualLigaturesOn     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CONTEXTUAL_LIGATURES_ON
+	AATLayoutFeatureSelectorContextualLigaturesOff    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CONTEXTUAL_LIGATURES_OFF
+	AATLayoutFeatureSelectorHistoricalLigaturesOn     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HISTORICAL_LIGATURES_ON
+	AATLayoutFeatureSelectorHistoricalLigaturesOff    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HISTORICAL_LIGATURES_OFF
+
+	// AATLayoutFeatureTypeLetterCase
+	AATLayoutFeatureSelectorUpperAndLowerCase       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_UPPER_AND_LOWER_CASE
+	AATLayoutFeatureSelectorAllCaps                 AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ALL_CAPS
+	AATLayoutFeatureSelectorAllLowerCase            AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ALL_LOWER_CASE
+	AATLayoutFeatureSelectorSmallCaps               AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SMALL_CAPS
+	AATLayoutFeatureSelectorInitialCaps             AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_INITIAL_CAPS
+	AATLayoutFeatureSelectorInitialCapsAndSmallCaps AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_INITIAL_CAPS_AND_SMALL_CAPS
+
+	// AATLayoutFeatureTypeVerticalSubstitution
+	AATLayoutFeatureSelectorSubstituteVerticalFormsOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SUBSTITUTE_VERTICAL_FORMS_ON
+	AATLayoutFeatureSelectorSubstituteVerticalFormsOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SUBSTITUTE_VERTICAL_FORMS_OFF
+
+	// AATLayoutFeatureTypeLinguisticRearrangement
+	AATLayoutFeatureSelectorLinguisticRearrangementOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_LINGUISTIC_REARRANGEMENT_ON
+	AATLayoutFeatureSelectorLinguisticRearrangementOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_LINGUISTIC_REARRANGEMENT_OFF
+
+	// AATLayoutFeatureTypeNumberSpacing
+	AATLayoutFeatureSelectorMonospacedNumbers   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_MONOSPACED_NUMBERS
+	AATLayoutFeatureSelectorProportionalNumbers AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_PROPORTIONAL_NUMBERS
+	AATLayoutFeatureSelectorThirdWidthNumbers   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_THIRD_WIDTH_NUMBERS
+	AATLayoutFeatureSelectorQuarterWidthNumbers AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_QUARTER_WIDTH_NUMBERS
+
+	// AATLayoutFeatureTypeSmartSwashType
+	AATLayoutFeatureSelectorWordInitialSwashesOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_WORD_INITIAL_SWASHES_ON
+	AATLayoutFeatureSelectorWordInitialSwashesOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_WORD_INITIAL_SWASHES_OFF
+	AATLayoutFeatureSelectorWordFinalSwashesOn    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_WORD_FINAL_SWASHES_ON
+	AATLayoutFeatureSelectorWordFinalSwashesOff   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_WORD_FINAL_SWASHES_OFF
+	AATLayoutFeatureSelectorLineInitialSwashesOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_LINE_INITIAL_SWASHES_ON
+	AATLayoutFeatureSelectorLineInitialSwashesOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_LINE_INITIAL_SWASHES_OFF
+	AATLayoutFeatureSelectorLineFinalSwashesOn    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_LINE_FINAL_SWASHES_ON
+	AATLayoutFeatureSelectorLineFinalSwashesOff   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_LINE_FINAL_SWASHES_OFF
+	AATLayoutFeatureSelectorNonFinalSwashesOn     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NON_FINAL_SWASHES_ON
+	AATLayoutFeatureSelectorNonFinalSwashesOff    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NON_FINAL_SWASHES_OFF
+
+	// AATLayoutFeatureTypeDiacriticsType
+	AATLayoutFeatureSelectorShowDiacritics      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SHOW_DIACRITICS
+	AATLayoutFeatureSelectorHideDiacritics      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HIDE_DIACRITICS
+	AATLayoutFeatureSelectorDecomposeDiacritics AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DECOMPOSE_DIACRITICS
+
+	// AATLayoutFeatureTypeVerticalPosition
+	AATLayoutFeatureSelectorNormalPosition      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NORMAL_POSITION
+	AATLayoutFeatureSelectorSuperiors           AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SUPERIORS
+	AATLayoutFeatureSelectorInferiors           AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_INFERIORS
+	AATLayoutFeatureSelectorOrdinals            AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ORDINALS
+	AATLayoutFeatureSelectorScientificInferiors AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SCIENTIFIC_INFERIORS
+
+	// AATLayoutFeatureTypeFractions
+	AATLayoutFeatureSelectorNoFractions       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NO_FRACTIONS
+	AATLayoutFeatureSelectorVerticalFractions AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_VERTICAL_FRACTIONS
+	AATLayoutFeatureSelectorDiagonalFractions AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DIAGONAL_FRACTIONS
+
+	// AATLayoutFeatureTypeOverlappingCharactersType
+	AATLayoutFeatureSelectorPreventOverlapOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_PREVENT_OVERLAP_ON
+	AATLayoutFeatureSelectorPreventOverlapOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_PREVENT_OVERLAP_OFF
+
+	// AATLayoutFeatureTypeTypographicExtras
+	AATLayoutFeatureSelectorHyphensToEmDashOn    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HYPHENS_TO_EM_DASH_ON
+	AATLayoutFeatureSelectorHyphensToEmDashOff   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HYPHENS_TO_EM_DASH_OFF
+	AATLayoutFeatureSelectorHyphenToEnDashOn     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HYPHEN_TO_EN_DASH_ON
+	AATLayoutFeatureSelectorHyphenToEnDashOff    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HYPHEN_TO_EN_DASH_OFF
+	AATLayoutFeatureSelectorSlashedZeroOn        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SLASHED_ZERO_ON
+	AATLayoutFeatureSelectorSlashedZeroOff       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SLASHED_ZERO_OFF
+	AATLayoutFeatureSelectorFormInterrobangOn    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_FORM_INTERROBANG_ON
+	AATLayoutFeatureSelectorFormInterrobangOff   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_FORM_INTERROBANG_OFF
+	AATLayoutFeatureSelectorSmartQuotesOn        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SMART_QUOTES_ON
+	AATLayoutFeatureSelectorSmartQuotesOff       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SMART_QUOTES_OFF
+	AATLayoutFeatureSelectorPeriodsToEllipsisOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_PERIODS_TO_ELLIPSIS_ON
+	AATLayoutFeatureSelectorPeriodsToEllipsisOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_PERIODS_TO_ELLIPSIS_OFF
+
+	// AATLayoutFeatureTypeMathematicalExtras
+	AATLayoutFeatureSelectorHyphenToMinusOn        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HYPHEN_TO_MINUS_ON
+	AATLayoutFeatureSelectorHyphenToMinusOff       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HYPHEN_TO_MINUS_OFF
+	AATLayoutFeatureSelectorAsteriskToMultiplyOn   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ASTERISK_TO_MULTIPLY_ON
+	AATLayoutFeatureSelectorAsteriskToMultiplyOff  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ASTERISK_TO_MULTIPLY_OFF
+	AATLayoutFeatureSelectorSlashToDivideOn        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SLASH_TO_DIVIDE_ON
+	AATLayoutFeatureSelectorSlashToDivideOff       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SLASH_TO_DIVIDE_OFF
+	AATLayoutFeatureSelectorInequalityLigaturesOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_INEQUALITY_LIGATURES_ON
+	AATLayoutFeatureSelectorInequalityLigaturesOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_INEQUALITY_LIGATURES_OFF
+	AATLayoutFeatureSelectorExponentsOn            AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_EXPONENTS_ON
+	AATLayoutFeatureSelectorExponentsOff           AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_EXPONENTS_OFF
+	AATLayoutFeatureSelectorMathematicalGreekOn    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_MATHEMATICAL_GREEK_ON
+	AATLayoutFeatureSelectorMathematicalGreekOff   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_MATHEMATICAL_GREEK_OFF
+
+	// AATLayoutFeatureTypeOrnamentSetsType
+	AATLayoutFeatureSelectorNoOrnaments          AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NO_ORNAMENTS
+	AATLayoutFeatureSelectorDingbats             AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DINGBATS
+	AATLayoutFeatureSelectorPiCharacters         AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_PI_CHARACTERS
+	AATLayoutFeatureSelectorFleurons             AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_FLEURONS
+	AATLayoutFeatureSelectorDecorativeBorders    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DECORATIVE_BORDERS
+	AATLayoutFeatureSelectorInternationalSymbols AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_INTERNATIONAL_SYMBOLS
+	AATLayoutFeatureSelectorMathSymbols          AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_MATH_SYMBOLS
+
+	// AATLayoutFeatureTypeCharacterAlternatives
+	AATLayoutFeatureSelectorNoAlternates AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NO_ALTERNATES
+
+	// AATLayoutFeatureTypeDesignComplexityType
+	AATLayoutFeatureSelectorDesignLevel1 AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DESIGN_LEVEL1
+	AATLayoutFeatureSelectorDesignLevel2 AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DESIGN_LEVEL2
+	AATLayoutFeatureSelectorDesignLevel3 AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DESIGN_LEVEL3
+	AATLayoutFeatureSelectorDesignLevel4 AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DESIGN_LEVEL4
+	AATLayoutFeatureSelectorDesignLevel5 AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DESIGN_LEVEL5
+
+	// AATLayoutFeatureTypeStyleOptions
+	AATLayoutFeatureSelectorNoStyleOptions  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NO_STYLE_OPTIONS
+	AATLayoutFeatureSelectorDisplayText     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DISPLAY_TEXT
+	AATLayoutFeatureSelectorEngravedText    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ENGRAVED_TEXT
+	AATLayoutFeatureSelectorIlluminatedCaps AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ILLUMINATED_CAPS
+	AATLayoutFeatureSelectorTitlingCaps     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_TITLING_CAPS
+	AATLayoutFeatureSelectorTallCaps        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_TALL_CAPS
+
+	// AATLayoutFeatureTypeCharacterShape
+	AATLayoutFeatureSelectorTraditionalCharacters      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_TRADITIONAL_CHARACTERS
+	AATLayoutFeatureSelectorSimplifiedCharacters       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SIMPLIFIED_CHARACTERS
+	AATLayoutFeatureSelectorJIS1978Characters          AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_JIS1978_CHARACTERS
+	AATLayoutFeatureSelectorJIS1983Characters          AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_JIS1983_CHARACTERS
+	AATLayoutFeatureSelectorJIS1990Characters          AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_JIS1990_CHARACTERS
+	AATLayoutFeatureSelectorTraditionalAltOne          AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_TRADITIONAL_ALT_ONE
+	AATLayoutFeatureSelectorTraditionalAltTwo          AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_TRADITIONAL_ALT_TWO
+	AATLayoutFeatureSelectorTraditionalAltThree        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_TRADITIONAL_ALT_THREE
+	AATLayoutFeatureSelectorTraditionalAltFour         AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_TRADITIONAL_ALT_FOUR
+	AATLayoutFeatureSelectorTraditionalAltFive         AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_TRADITIONAL_ALT_FIVE
+	AATLayoutFeatureSelectorExpertCharacters           AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_EXPERT_CHARACTERS
+	AATLayoutFeatureSelectorJIS2004Characters          AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_JIS2004_CHARACTERS
+	AATLayoutFeatureSelectorHojoCharacters             AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HOJO_CHARACTERS
+	AATLayoutFeatureSelectorNLCCharacters              AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NLCCHARACTERS
+	AATLayoutFeatureSelectorTraditionalNamesCharacters AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_TRADITIONAL_NAMES_CHARACTERS
+
+	// AATLayoutFeatureTypeNumberCase
+	AATLayoutFeatureSelectorLowerCaseNumbers AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_LOWER_CASE_NUMBERS
+	AATLayoutFeatureSelectorUpperCaseNumbers AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_UPPER_CASE_NUMBERS
+
+	// AATLayoutFeatureTypeTextSpacing
+	AATLayoutFeatureSelectorProportionalText    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_PROPORTIONAL_TEXT
+	AATLayoutFeatureSelectorMonospacedText      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_MONOSPACED_TEXT
+	AATLayoutFeatureSelectorHalfWidthText       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HALF_WIDTH_TEXT
+	AATLayoutFeatureSelectorThirdWidthText      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_THIRD_WIDTH_TEXT
+	AATLayoutFeatureSelectorQuarterWidthText    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_QUARTER_WIDTH_TEXT
+	AATLayoutFeatureSelectorAltProportionalText AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ALT_PROPORTIONAL_TEXT
+	AATLayoutFeatureSelectorAltHalfWidthText    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ALT_HALF_WIDTH_TEXT
+
+	// AATLayoutFeatureTypeTransliteration
+	AATLayoutFeatureSelectorNoTransliteration      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NO_TRANSLITERATION
+	AATLayoutFeatureSelectorHanjaToHangul          AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HANJA_TO_HANGUL
+	AATLayoutFeatureSelectorHiraganaToKatakana     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HIRAGANA_TO_KATAKANA
+	AATLayoutFeatureSelectorKatakanaToHiragana     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_KATAKANA_TO_HIRAGANA
+	AATLayoutFeatureSelectorKanaToRomanization     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_KANA_TO_ROMANIZATION
+	AATLayoutFeatureSelectorRomanizationToHiragana AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ROMANIZATION_TO_HIRAGANA
+	AATLayoutFeatureSelectorRomanizationToKatakana AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ROMANIZATION_TO_KATAKANA
+	AATLayoutFeatureSelectorHanjaToHangulAltOne    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HANJA_TO_HANGUL_ALT_ONE
+	AATLayoutFeatureSelectorHanjaToHangulAltTwo    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HANJA_TO_HANGUL_ALT_TWO
+	AATLayoutFeatureSelectorHanjaToHangulAltThree  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HANJA_TO_HANGUL_ALT_THREE
+
+	// AATLayoutFeatureTypeAnnotationType
+	AATLayoutFeatureSelectorNoAnnotation                 AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NO_ANNOTATION
+	AATLayoutFeatureSelectorBoxAnnotation                AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_BOX_ANNOTATION
+	AATLayoutFeatureSelectorRoundedBoxAnnotation         AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ROUNDED_BOX_ANNOTATION
+	AATLayoutFeatureSelectorCircleAnnotation             AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CIRCLE_ANNOTATION
+	AATLayoutFeatureSelectorInvertedCircleAnnotation     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_INVERTED_CIRCLE_ANNOTATION
+	AATLayoutFeatureSelectorParenthesisAnnotation        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_PARENTHESIS_ANNOTATION
+	AATLayoutFeatureSelectorPeriodAnnotation             AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_PERIOD_ANNOTATION
+	AATLayoutFeatureSelectorRomanNumeralAnnotation       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ROMAN_NUMERAL_ANNOTATION
+	AATLayoutFeatureSelectorDiamondAnnotation            AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DIAMOND_ANNOTATION
+	AATLayoutFeatureSelectorInvertedBoxAnnotation        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_INVERTED_BOX_ANNOTATION
+	AATLayoutFeatureSelectorInvertedRoundedBoxAnnotation AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_INVERTED_ROUNDED_BOX_ANNOTATION
+
+	// AATLayoutFeatureTypeKanaSpacingType
+	AATLayoutFeatureSelectorFullWidthKana    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_FULL_WIDTH_KANA
+	AATLayoutFeatureSelectorProportionalKana AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_PROPORTIONAL_KANA
+
+	// AATLayoutFeatureTypeIdeographicSpacingType
+	AATLayoutFeatureSelectorFullWidthIdeographs    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_FULL_WIDTH_IDEOGRAPHS
+	AATLayoutFeatureSelectorProportionalIdeographs AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_PROPORTIONAL_IDEOGRAPHS
+	AATLayoutFeatureSelectorHalfWidthIdeographs    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HALF_WIDTH_IDEOGRAPHS
+
+	// AATLayoutFeatureTypeUnicodeDecompositionType
+	AATLayoutFeatureSelectorCanonicalCompositionOn      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CANONICAL_COMPOSITION_ON
+	AATLayoutFeatureSelectorCanonicalCompositionOff     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CANONICAL_COMPOSITION_OFF
+	AATLayoutFeatureSelectorCompatibilityCompositionOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_COMPATIBILITY_COMPOSITION_ON
+	AATLayoutFeatureSelectorCompatibilityCompositionOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_COMPATIBILITY_COMPOSITION_OFF
+	AATLayoutFeatureSelectorTranscodingCompositionOn    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_TRANSCODING_COMPOSITION_ON
+	AATLayoutFeatureSelectorTranscodingCompositionOff   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_TRANSCODING_COMPOSITION_OFF
+
+	// AATLayoutFeatureTypeRubyKana
+	AATLayoutFeatureSelectorNoRubyKana  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NO_RUBY_KANA // Deprecated, use AATLayoutFeatureSelectorRubyKanaOff instead.
+	AATLayoutFeatureSelectorRubyKana    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_RUBY_KANA    // Deprecated, use AATLayoutFeatureSelectorRubyKanaOn instead.
+	AATLayoutFeatureSelectorRubyKanaOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_RUBY_KANA_ON
+	AATLayoutFeatureSelectorRubyKanaOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_RUBY_KANA_OFF
+
+	// AATLayoutFeatureTypeCJKSymbolAlternativesType
+	AATLayoutFeatureSelectorNoCJKSymbolAlternatives AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NO_CJK_SYMBOL_ALTERNATIVES
+	AATLayoutFeatureSelectorCJKSymbolAltOne         AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CJK_SYMBOL_ALT_ONE
+	AATLayoutFeatureSelectorCJKSymbolAltTwo         AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CJK_SYMBOL_ALT_TWO
+	AATLayoutFeatureSelectorCJKSymbolAltThree       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CJK_SYMBOL_ALT_THREE
+	AATLayoutFeatureSelectorCJKSymbolAltFour        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CJK_SYMBOL_ALT_FOUR
+	AATLayoutFeatureSelectorCJKSymbolAltFive        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CJK_SYMBOL_ALT_FIVE
+
+	// AATLayoutFeatureTypeIdeographicAlternativesType
+	AATLayoutFeatureSelectorNoIdeographicAlternatives AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NO_IDEOGRAPHIC_ALTERNATIVES
+	AATLayoutFeatureSelectorIdeographicAltOne         AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_IDEOGRAPHIC_ALT_ONE
+	AATLayoutFeatureSelectorIdeographicAltTwo         AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_IDEOGRAPHIC_ALT_TWO
+	AATLayoutFeatureSelectorIdeographicAltThree       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_IDEOGRAPHIC_ALT_THREE
+	AATLayoutFeatureSelectorIdeographicAltFour        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_IDEOGRAPHIC_ALT_FOUR
+	AATLayoutFeatureSelectorIdeographicAltFive        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_IDEOGRAPHIC_ALT_FIVE
+
+	// AATLayoutFeatureTypeCJKVerticalRomanPlacementType
+	AATLayoutFeatureSelectorCJKVerticalRomanCentered  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CJK_VERTICAL_ROMAN_CENTERED
+	AATLayoutFeatureSelectorCJKVerticalRomanHBaseline AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CJK_VERTICAL_ROMAN_HBASELINE
+
+	// AATLayoutFeatureTypeItalicCJKRoman
+	AATLayoutFeatureSelectorNoCJKItalicRoman  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NO_CJK_ITALIC_ROMAN // Deprecated, use AATLayoutFeatureSelectorCJKItalicRomanOff instead.
+	AATLayoutFeatureSelectorCJKItalicRoman    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CJK_ITALIC_ROMAN    // Deprecated, use AATLayoutFeatureSelectorCJKItalicRomanOn instead.
+	AATLayoutFeatureSelectorCJKItalicRomanOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CJK_ITALIC_ROMAN_ON
+	AATLayoutFeatureSelectorCJKItalicRomanOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CJK_ITALIC_ROMAN_OFF
+
+	// AATLayoutFeatureTypeCaseSensitiveLayout
+	AATLayoutFeatureSelectorCaseSensitiveLayoutOn   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CASE_SENSITIVE_LAYOUT_ON
+	AATLayoutFeatureSelectorCaseSensitiveLayoutOff  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CASE_SENSITIVE_LAYOUT_OFF
+	AATLayoutFeatureSelectorCaseSensitiveSpacingOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CASE_SENSITIVE_SPACING_ON
+	AATLayoutFeatureSelectorCaseSensitiveSpacingOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CASE_SENSITIVE_SPACING_OFF
+
+	// AATLayoutFeatureTypeAlternateKana
+	AATLayoutFeatureSelectorAlternateHorizKanaOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ALTERNATE_HORIZ_KANA_ON
+	AATLayoutFeatureSelectorAlternateHorizKanaOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ALTERNATE_HORIZ_KANA_OFF
+	AATLayoutFeatureSelectorAlternateVertKanaOn   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ALTERNATE_VERT_KANA_ON
+	AATLayoutFeatureSelectorAlternateVertKanaOff  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_ALTERNATE_VERT_KANA_OFF
+
+	// AATLayoutFeatureTypeStylisticAlternatives
+	AATLayoutFeatureSelectorNoStylisticAlternates    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_NO_STYLISTIC_ALTERNATES
+	AATLayoutFeatureSelectorStylisticAltOneOn        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_ONE_ON
+	AATLayoutFeatureSelectorStylisticAltOneOff       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_ONE_OFF
+	AATLayoutFeatureSelectorStylisticAltTwoOn        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_TWO_ON
+	AATLayoutFeatureSelectorStylisticAltTwoOff       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_TWO_OFF
+	AATLayoutFeatureSelectorStylisticAltThreeOn      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_THREE_ON
+	AATLayoutFeatureSelectorStylisticAltThreeOff     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_THREE_OFF
+	AATLayoutFeatureSelectorStylisticAltFourOn       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_FOUR_ON
+	AATLayoutFeatureSelectorStylisticAltFourOff      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_FOUR_OFF
+	AATLayoutFeatureSelectorStylisticAltFiveOn       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_FIVE_ON
+	AATLayoutFeatureSelectorStylisticAltFiveOff      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_FIVE_OFF
+	AATLayoutFeatureSelectorStylisticAltSixOn        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_SIX_ON
+	AATLayoutFeatureSelectorStylisticAltSixOff       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_SIX_OFF
+	AATLayoutFeatureSelectorStylisticAltSevenOn      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_SEVEN_ON
+	AATLayoutFeatureSelectorStylisticAltSevenOff     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_SEVEN_OFF
+	AATLayoutFeatureSelectorStylisticAltEightOn      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_EIGHT_ON
+	AATLayoutFeatureSelectorStylisticAltEightOff     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_EIGHT_OFF
+	AATLayoutFeatureSelectorStylisticAltNineOn       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_NINE_ON
+	AATLayoutFeatureSelectorStylisticAltNineOff      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_NINE_OFF
+	AATLayoutFeatureSelectorStylisticAltTenOn        AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_TEN_ON
+	AATLayoutFeatureSelectorStylisticAltTenOff       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_TEN_OFF
+	AATLayoutFeatureSelectorStylisticAltElevenOn     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_ELEVEN_ON
+	AATLayoutFeatureSelectorStylisticAltElevenOff    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_ELEVEN_OFF
+	AATLayoutFeatureSelectorStylisticAltTwelveOn     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_TWELVE_ON
+	AATLayoutFeatureSelectorStylisticAltTwelveOff    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_TWELVE_OFF
+	AATLayoutFeatureSelectorStylisticAltThirteenOn   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_THIRTEEN_ON
+	AATLayoutFeatureSelectorStylisticAltThirteenOff  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_THIRTEEN_OFF
+	AATLayoutFeatureSelectorStylisticAltFourteenOn   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_FOURTEEN_ON
+	AATLayoutFeatureSelectorStylisticAltFourteenOff  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_FOURTEEN_OFF
+	AATLayoutFeatureSelectorStylisticAltFifteenOn    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_FIFTEEN_ON
+	AATLayoutFeatureSelectorStylisticAltFifteenOff   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_FIFTEEN_OFF
+	AATLayoutFeatureSelectorStylisticAltSixteenOn    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_SIXTEEN_ON
+	AATLayoutFeatureSelectorStylisticAltSixteenOff   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_SIXTEEN_OFF
+	AATLayoutFeatureSelectorStylisticAltSeventeenOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_SEVENTEEN_ON
+	AATLayoutFeatureSelectorStylisticAltSeventeenOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_SEVENTEEN_OFF
+	AATLayoutFeatureSelectorStylisticAltEighteenOn   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_EIGHTEEN_ON
+	AATLayoutFeatureSelectorStylisticAltEighteenOff  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_EIGHTEEN_OFF
+	AATLayoutFeatureSelectorStylisticAltNineteenOn   AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_NINETEEN_ON
+	AATLayoutFeatureSelectorStylisticAltNineteenOff  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_NINETEEN_OFF
+	AATLayoutFeatureSelectorStylisticAltTwentyOn     AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_TWENTY_ON
+	AATLayoutFeatureSelectorStylisticAltTwentyOff    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_STYLISTIC_ALT_TWENTY_OFF
+
+	// AATLayoutFeatureTypeContextualAlternatives
+	AATLayoutFeatureSelectorContextualAlternatesOn       AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CONTEXTUAL_ALTERNATES_ON
+	AATLayoutFeatureSelectorContextualAlternatesOff      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CONTEXTUAL_ALTERNATES_OFF
+	AATLayoutFeatureSelectorSwashAlternatesOn            AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SWASH_ALTERNATES_ON
+	AATLayoutFeatureSelectorSwashAlternatesOff           AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_SWASH_ALTERNATES_OFF
+	AATLayoutFeatureSelectorContextualSwashAlternatesOn  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CONTEXTUAL_SWASH_ALTERNATES_ON
+	AATLayoutFeatureSelectorContextualSwashAlternatesOff AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_CONTEXTUAL_SWASH_ALTERNATES_OFF
+
+	// AATLayoutFeatureTypeLowerCase
+	AATLayoutFeatureSelectorDefaultLowerCase    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DEFAULT_LOWER_CASE
+	AATLayoutFeatureSelectorLowerCaseSmallCaps  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_LOWER_CASE_SMALL_CAPS
+	AATLayoutFeatureSelectorLowerCasePetiteCaps AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_LOWER_CASE_PETITE_CAPS
+
+	// AATLayoutFeatureTypeUpperCase
+	AATLayoutFeatureSelectorDefaultUpperCase    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DEFAULT_UPPER_CASE
+	AATLayoutFeatureSelectorUpperCaseSmallCaps  AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_UPPER_CASE_SMALL_CAPS
+	AATLayoutFeatureSelectorUpperCasePetiteCaps AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_UPPER_CASE_PETITE_CAPS
+
+	// AATLayoutFeatureTypeCJKRomanSpacingType
+	AATLayoutFeatureSelectorHalfWidthCJKRoman    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_HALF_WIDTH_CJK_ROMAN
+	AATLayoutFeatureSelectorProportionalCJKRoman AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_PROPORTIONAL_CJK_ROMAN
+	AATLayoutFeatureSelectorDefaultCJKRoman      AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_DEFAULT_CJK_ROMAN
+	AATLayoutFeatureSelectorFullWidthCJKRoman    AATLayoutFeatureSelector = C.HB_AAT_LAYOUT_FEATURE_SELECTOR_FULL_WIDTH_CJK_ROMAN
+)
+
+// AATLayoutFeatureSelectorInfo holds information about a selector of an AAT
+// feature type.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-aat-layout.html#hb-aat-layout-feature-selector-info-t
+type AATLayoutFeatureSelectorInfo struct {
+	NameID   OTNameID                 // The selector's name identifier.
+	Enable   AATLayoutFeatureSelector // The value to turn the selector on.
+	Disable  AATLayoutFeatureSelector // The value to turn the selector off.
+	reserved uint32                   // [private]
+}
+
+// AATLayoutGetFeatureTypes fetches all of the AAT feature types defined in the
+// face's feat table.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-aat-layout.html#hb-aat-layout-get-feature-types
+func AATLayoutGetFeatureTypes(face Face) []AATLayoutFeatureType {
+	var count C.uint
+	total := C.hb_aat_layout_get_feature_types(face, 0, &count, nil)
+	if total == 0 {
+		return nil
+	}
+
+	features := make([]AATLayoutFeatureType, total)
+	count = total
+	C.hb_aat_layout_get_feature_types(face, 0, &count, (*C.hb_aat_layout_feature_type_t)(unsafe.Pointer(&features[0])))
+
+	return features[:count]
+}
+
+// AATLayoutFeatureTypeGetNameID fetches the name identifier of the specified
+// feature type in the face's name table.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-aat-layout.html#hb-aat-layout-feature-type-get-name-id
+func AATLayoutFeatureTypeGetNameID(face Face, featureType AATLayoutFeatureType) OTNameID {
+	return OTNameID(C.hb_aat_layout_feature_type_get_name_id(face, C.hb_aat_layout_feature_type_t(featureType)))
+}
+
+// AATLayoutFeatureTypeGetSelectorInfos fetches all of the selectors of the
+// specified feature type.
+//
+// For exclusive feature types, defaultIndex is the index of the selector which
+// is enabled by default, and Disable of every selector is the default selector.
+// For non-exclusive feature types, defaultIndex is AATLayoutNoSelectorIndex and
+// every selector is an independent on/off toggle.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-aat-layout.html#hb-aat-layout-feature-type-get-selector-infos
+func AATLayoutFeatureTypeGetSelectorInfos(face Face, featureType AATLayoutFeatureType) (selectors []AATLayoutFeatureSelectorInfo, defaultIndex uint32) {
+	var count C.uint
+	total := C.hb_aat_layout_feature_type_get_selector_infos(face, C.hb_aat_layout_feature_type_t(featureType), 0, &count, nil, (*C.uint)(&defaultIndex))
+	if total == 0 {
+		return nil, defaultIndex
+	}
+
+	selectors = make([]AATLayoutFeatureSelectorInfo, total)
+	count = total
+	C.hb_aat_layout_feature_type_get_selector_infos(face, C.hb_aat_layout_feature_type_t(featureType), 0, &count, (*C.hb_aat_layout_feature_selector_info_t)(unsafe.Pointer(&selectors[0])), nil)
+
+	return selectors[:count], defaultIndex
+}
+
+// AATLayoutHasSubstitution tests whether the specified face includes any
+// substitutions in the morx or mort tables.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-aat-layout.html#hb-aat-layout-has-substitution
+func AATLayoutHasSubstitution(face Face) bool {
+	return C.hb_aat_layout_has_substitution(face) == 1
+}
+
+// AATLayoutHasPositioning tests whether the specified face includes any
+// positioning information in the kerx table.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-aat-layout.html#hb-aat-layout-has-positioning
+func AATLayoutHasPositioning(face Face) bool {
+	return C.hb_aat_layout_has_positioning(face) == 1
+}
+
+// AATLayoutHasTracking tests whether the specified face includes any tracking
+// information in the trak table.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-aat-layout.html#hb-aat-layout-has-tracking
+func AATLayoutHasTracking(face Face) bool {
+	return C.hb_aat_layout_has_tracking(face) == 1
+}
+
+// AATLayoutHasAnchorPoints tests whether the specified face includes an ankr
+// table, which is used by kerx to attach glyphs at anchor points.
+//
+// HarfBuzz doesn't provide a dedicated query for it, so it's checked by looking
+// the table up in the face.
+func AATLayoutHasAnchorPoints(face Face) bool {
+	blob := FaceReferenceTable(face, TagFromString("ankr"))
+	defer BlobDestroy(blob)
+
+	return BlobGetLength(blob) > 0
+}
+
+// The AAT feature types and selectors hb-aat-layout.cc maps without a name in
+// hb-aat-layout.h. The disabling selectors of the exclusive types, whose
+// selectors can't be turned off, are past their last selector, so that no font
+// has them and it falls back to its default one.
+const (
+	aatLayoutFeatureTypeHistoricalForms AATLayoutFeatureType = 40
+
+	aatLayoutFeatureSelectorHistoricalFormsOn  AATLayoutFeatureSelector = 0
+	aatLayoutFeatureSelectorHistoricalFormsOff AATLayoutFeatureSelector = 1
+	aatLayoutFeatureSelectorUnicaseOn          AATLayoutFeatureSelector = 14
+	aatLayoutFeatureSelectorUnicaseOff         AATLayoutFeatureSelector = 15
+	aatLayoutFeatureSelectorRotatedFormsOn     AATLayoutFeatureSelector = 2
+	aatLayoutFeatureSelectorRotatedFormsOff    AATLayoutFeatureSelector = 3
+
+	aatLayoutFeatureSelectorNoCharacterShape AATLayoutFeatureSelector = 16
+	aatLayoutFeatureSelectorNoNumberCase     AATLayoutFeatureSelector = 2
+	aatLayoutFeatureSelectorNoNumberSpacing  AATLayoutFeatureSelector = 4
+	aatLayoutFeatureSelectorNoTextSpacing    AATLayoutFeatureSelector = 7
+)
+
+// aatFeatureMapping is a port of HarfBuzz's internal table which maps
+// OpenType feature tags to AAT feature types and selectors.
+var aatFeatureMapping = []struct {
+	tag     string
+	typ     AATLayoutFeatureType
+	enable  AATLayoutFeatureSelector
+	disable AATLayoutFeatureSelector
+}{
+	{"afrc", AATLayoutFeatureTypeFractions, AATLayoutFeatureSelectorVerticalFractions, AATLayoutFeatureSelectorNoFractions},
+	{"c2pc", AATLayoutFeatureTypeUpperCase, AATLayoutFeatureSelectorUpperCasePetiteCaps, AATLayoutFeatureSelectorDefaultUpperCase},
+	{"c2sc", AATLayoutFeatureTypeUpperCase, AATLayoutFeatureSelectorUpperCaseSmallCaps, AATLayoutFeatureSelectorDefaultUpperCase},
+	{"calt", AATLayoutFeatureTypeContextualAlternatives, AATLayoutFeatureSelectorContextualAlternatesOn, AATLayoutFeatureSelectorContextualAlternatesOff},
+	{"case", AATLayoutFeatureTypeCaseSensitiveLayout, AATLayoutFeatureSelectorCaseSensitiveLayoutOn, AATLayoutFeatureSelectorCaseSensitiveLayoutOff},
+	{"clig", AATLayoutFeatureTypeLigatures, AATLayoutFeatureSelectorContextualLigaturesOn, AATLayoutFeatureSelectorContextualLigaturesOff},
+	{"cpsp", AATLayoutFeatureTypeCaseSensitiveLayout, AATLayoutFeatureSelectorCaseSensitiveSpacingOn, AATLayoutFeatureSelectorCaseSensitiveSpacingOff},
+	{"cswh", AATLayoutFeatureTypeContextualAlternatives, AATLayoutFeatureSelectorContextualSwashAlternatesOn, AATLayoutFeatureSelectorContextualSwashAlternatesOff},
+	{"dlig", AATLayoutFeatureTypeLigatures, AATLayoutFeatureSelectorRareLigaturesOn, AATLayoutFeatureSelectorRareLigaturesOff},
+	{"expt", AATLayoutFeatureTypeCharacterShape, AATLayoutFeatureSelectorExpertCharacters, aatLayoutFeatureSelectorNoCharacterShape},
+	{"frac", AATLayoutFeatureTypeFractions, AATLayoutFeatureSelectorDiagonalFractions, AATLayoutFeatureSelectorNoFractions},
+	{"fwid", AATLayoutFeatureTypeTextSpacing, AATLayoutFeatureSelectorMonospacedText, aatLayoutFeatureSelectorNoTextSpacing},
+	{"halt", AATLayoutFeatureTypeTextSpacing, AATLayoutFeatureSelectorAltHalfWidthText, aatLayoutFeatureSelectorNoTextSpacing},
+	{"hist", aatLayoutFeatureTypeHistoricalForms, aatLayoutFeatureSelectorHistoricalFormsOn, aatLayoutFeatureSelectorHistoricalFormsOff},
+	{"hkna", AATLayoutFeatureTypeAlternateKana, AATLayoutFeatureSelectorAlternateHorizKanaOn, AATLayoutFeatureSelectorAlternateHorizKanaOff},
+	{"hlig", AATLayoutFeatureTypeLigatures, AATLayoutFeatureSelectorHistoricalLigaturesOn, AATLayoutFeatureSelectorHistoricalLigaturesOff},
+	{"hngl", AATLayoutFeatureTypeTransliteration, AATLayoutFeatureSelectorHanjaToHangul, AATLayoutFeatureSelectorNoTransliteration},
+	{"hojo", AATLayoutFeatureTypeCharacterShape, AATLayoutFeatureSelectorHojoCharacters, aatLayoutFeatureSelectorNoCharacterShape},
+	{"hwid", AATLayoutFeatureTypeTextSpacing, AATLayoutFeatureSelectorHalfWidthText, aatLayoutFeatureSelectorNoTextSpacing},
+	{"ital", AATLayoutFeatureTypeItalicCJKRoman, AATLayoutFeatureSelectorCJKItalicRomanOn, AATLayoutFeatureSelectorCJKItalicRomanOff},
+	{"jp04", AATLayoutFeatureTypeCharacterShape, AATLayoutFeatureSelectorJIS2004Characters, aatLayoutFeatureSelectorNoCharacterShape},
+	{"jp78", AATLayoutFeatureTypeCharacterShape, AATLayoutFeatureSelectorJIS1978Characters, aatLayoutFeatureSelectorNoCharacterShape},
+	{"jp83", AATLayoutFeatureTypeCharacterShape, AATLayoutFeatureSelectorJIS1983Characters, aatLayoutFeatureSelectorNoCharacterShape},
+	{"jp90", AATLayoutFeatureTypeCharacterShape, AATLayoutFeatureSelectorJIS1990Characters, aatLayoutFeatureSelectorNoCharacterShape},
+	{"liga", AATLayoutFeatureTypeLigatures, AATLayoutFeatureSelectorCommonLigaturesOn, AATLayoutFeatureSelectorCommonLigaturesOff},
+	{"lnum", AATLayoutFeatureTypeNumberCase, AATLayoutFeatureSelectorUpperCaseNumbers, aatLayoutFeatureSelectorNoNumberCase},
+	{"mgrk", AATLayoutFeatureTypeMathematicalExtras, AATLayoutFeatureSelectorMathematicalGreekOn, AATLayoutFeatureSelectorMathematicalGreekOff},
+	{"nlck", AATLayoutFeatureTypeCharacterShape, AATLayoutFeatureSelectorNLCCharacters, aatLayoutFeatureSelectorNoCharacterShape},
+	{"onum", AATLayoutFeatureTypeNumberCase, AATLayoutFeatureSelectorLowerCaseNumbers, aatLayoutFeatureSelectorNoNumberCase},
+	{"ordn", AATLayoutFeatureTypeVerticalPosition, AATLayoutFeatureSelectorOrdinals, AATLayoutFeatureSelectorNormalPosition},
+	{"palt", AATLayoutFeatureTypeTextSpacing, AATLayoutFeatureSelectorAltProportionalText, aatLayoutFeatureSelectorNoTextSpacing},
+	{"pcap", AATLayoutFeatureTypeLowerCase, AATLayoutFeatureSelectorLowerCasePetiteCaps, AATLayoutFeatureSelectorDefaultLowerCase},
+	{"pkna", AATLayoutFeatureTypeTextSpacing, AATLayoutFeatureSelectorProportionalText, aatLayoutFeatureSelectorNoTextSpacing},
+	{"pnum", AATLayoutFeatureTypeNumberSpacing, AATLayoutFeatureSelectorProportionalNumbers, aatLayoutFeatureSelectorNoNumberSpacing},
+	{"pwid", AATLayoutFeatureTypeTextSpacing, AATLayoutFeatureSelectorProportionalText, aatLayoutFeatureSelectorNoTextSpacing},
+	{"qwid", AATLayoutFeatureTypeTextSpacing, AATLayoutFeatureSelectorQuarterWidthText, aatLayoutFeatureSelectorNoTextSpacing},
+	{"rlig", AATLayoutFeatureTypeLigatures, AATLayoutFeatureSelectorRequiredLigaturesOn, AATLayoutFeatureSelectorRequiredLigaturesOff},
+	{"ruby", AATLayoutFeatureTypeRubyKana, AATLayoutFeatureSelectorRubyKanaOn, AATLayoutFeatureSelectorRubyKanaOff},
+	{"sinf", AATLayoutFeatureTypeVerticalPosition, AATLayoutFeatureSelectorScientificInferiors, AATLayoutFeatureSelectorNormalPosition},
+	{"smcp", AATLayoutFeatureTypeLowerCase, AATLayoutFeatureSelectorLowerCaseSmallCaps, AATLayoutFeatureSelectorDefaultLowerCase},
+	{"smpl", AATLayoutFeatureTypeCharacterShape, AATLayoutFeatureSelectorSimplifiedCharacters, aatLayoutFeatureSelectorNoCharacterShape},
+	{"ss01", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltOneOn, AATLayoutFeatureSelectorStylisticAltOneOff},
+	{"ss02", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltTwoOn, AATLayoutFeatureSelectorStylisticAltTwoOff},
+	{"ss03", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltThreeOn, AATLayoutFeatureSelectorStylisticAltThreeOff},
+	{"ss04", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltFourOn, AATLayoutFeatureSelectorStylisticAltFourOff},
+	{"ss05", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltFiveOn, AATLayoutFeatureSelectorStylisticAltFiveOff},
+	{"ss06", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltSixOn, AATLayoutFeatureSelectorStylisticAltSixOff},
+	{"ss07", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltSevenOn, AATLayoutFeatureSelectorStylisticAltSevenOff},
+	{"ss08", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltEightOn, AATLayoutFeatureSelectorStylisticAltEightOff},
+	{"ss09", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltNineOn, AATLayoutFeatureSelectorStylisticAltNineOff},
+	{"ss10", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltTenOn, AATLayoutFeatureSelectorStylisticAltTenOff},
+	{"ss11", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltElevenOn, AATLayoutFeatureSelectorStylisticAltElevenOff},
+	{"ss12", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltTwelveOn, AATLayoutFeatureSelectorStylisticAltTwelveOff},
+	{"ss13", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltThirteenOn, AATLayoutFeatureSelectorStylisticAltThirteenOff},
+	{"ss14", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltFourteenOn, AATLayoutFeatureSelectorStylisticAltFourteenOff},
+	{"ss15", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltFifteenOn, AATLayoutFeatureSelectorStylisticAltFifteenOff},
+	{"ss16", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltSixteenOn, AATLayoutFeatureSelectorStylisticAltSixteenOff},
+	{"ss17", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltSeventeenOn, AATLayoutFeatureSelectorStylisticAltSeventeenOff},
+	{"ss18", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltEighteenOn, AATLayoutFeatureSelectorStylisticAltEighteenOff},
+	{"ss19", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltNineteenOn, AATLayoutFeatureSelectorStylisticAltNineteenOff},
+	{"ss20", AATLayoutFeatureTypeStylisticAlternatives, AATLayoutFeatureSelectorStylisticAltTwentyOn, AATLayoutFeatureSelectorStylisticAltTwentyOff},
+	{"subs", AATLayoutFeatureTypeVerticalPosition, AATLayoutFeatureSelectorInferiors, AATLayoutFeatureSelectorNormalPosition},
+	{"sups", AATLayoutFeatureTypeVerticalPosition, AATLayoutFeatureSelectorSuperiors, AATLayoutFeatureSelectorNormalPosition},
+	{"swsh", AATLayoutFeatureTypeContextualAlternatives, AATLayoutFeatureSelectorSwashAlternatesOn, AATLayoutFeatureSelectorSwashAlternatesOff},
+	{"titl", AATLayoutFeatureTypeStyleOptions, AATLayoutFeatureSelectorTitlingCaps, AATLayoutFeatureSelectorNoStyleOptions},
+	{"tnam", AATLayoutFeatureTypeCharacterShape, AATLayoutFeatureSelectorTraditionalNamesCharacters, aatLayoutFeatureSelectorNoCharacterShape},
+	{"tnum", AATLayoutFeatureTypeNumberSpacing, AATLayoutFeatureSelectorMonospacedNumbers, aatLayoutFeatureSelectorNoNumberSpacing},
+	{"trad", AATLayoutFeatureTypeCharacterShape, AATLayoutFeatureSelectorTraditionalCharacters, aatLayoutFeatureSelectorNoCharacterShape},
+	{"twid", AATLayoutFeatureTypeTextSpacing, AATLayoutFeatureSelectorThirdWidthText, aatLayoutFeatureSelectorNoTextSpacing},
+	{"unic", AATLayoutFeatureTypeLetterCase, aatLayoutFeatureSelectorUnicaseOn, aatLayoutFeatureSelectorUnicaseOff},
+	{"valt", AATLayoutFeatureTypeTextSpacing, AATLayoutFeatureSelectorAltProportionalText, aatLayoutFeatureSelectorNoTextSpacing},
+	{"vert", AATLayoutFeatureTypeVerticalSubstitution, AATLayoutFeatureSelectorSubstituteVerticalFormsOn, AATLayoutFeatureSelectorSubstituteVerticalFormsOff},
+	{"vhal", AATLayoutFeatureTypeTextSpacing, AATLayoutFeatureSelectorAltHalfWidthText, aatLayoutFeatureSelectorNoTextSpacing},
+	{"vkna", AATLayoutFeatureTypeAlternateKana, AATLayoutFeatureSelectorAlternateVertKanaOn, AATLayoutFeatureSelectorAlternateVertKanaOff},
+	{"vpal", AATLayoutFeatureTypeTextSpacing, AATLayoutFeatureSelectorAltProportionalText, aatLayoutFeatureSelectorNoTextSpacing},
+	{"vrt2", AATLayoutFeatureTypeVerticalSubstitution, AATLayoutFeatureSelectorSubstituteVerticalFormsOn, AATLayoutFeatureSelectorSubstituteVerticalFormsOff},
+	{"vrtr", AATLayoutFeatureTypeVerticalSubstitution, aatLayoutFeatureSelectorRotatedFormsOn, aatLayoutFeatureSelectorRotatedFormsOff},
+	{"zero", AATLayoutFeatureTypeTypographicExtras, AATLayoutFeatureSelectorSlashedZeroOn, AATLayoutFeatureSelectorSlashedZeroOff},
+}
+
+// AATLayoutFeatureToFeature returns the Feature which makes HarfBuzz apply the
+// given selector of an AAT feature type when passed to Shape. ok is false if
+// HarfBuzz has no OpenType feature mapped to that selector.
+//
+// HarfBuzz only drives AAT features through OpenType feature tags, so the
+// returned feature's tag is the one HarfBuzz maps to featureType, and its value
+// is 1 for the enabling selector and 0 for the disabling one. Selectors of the
+// AATLayoutFeatureTypeCharacterAlternatives type map to the aalt feature, with
+// the selector as its value.
+func AATLayoutFeatureToFeature(featureType AATLayoutFeatureType, selector AATLayoutFeatureSelector) (feature Feature, ok bool) {
+	feature.Start = FeatureGlobalStart
+	feature.End = FeatureGlobalEnd
+
+	if featureType == AATLayoutFeatureTypeCharacterAlternatives {
+		feature.Tag = TagFromString("aalt")
+		feature.Value = uint32(selector)
+		return feature, true
+	}
+
+	for _, mapping := range aatFeatureMapping {
+		if mapping.typ != featureType {
+			continue
+		}
+
+		switch selector {
+		case mapping.enable:
+			feature.Tag, feature.Value = TagFromString(mapping.tag), 1
+			return feature, true
+		case mapping.disable:
+			feature.Tag, feature.Value = TagFromString(mapping.tag), 0
+			return feature, true
+		}
+	}
+
+	return feature, false
+}
@@ -2,9 +2,12 @@ package hb
 
 // #include <stdlib.h>
 // #include <hb.h>
+//
+// extern hb_bool_t goBufferMessageFunc(hb_buffer_t *, hb_font_t *, char *, void *);
 import "C"
 
 import (
+	"fmt"
 	"unsafe"
 )
 
@@ -251,17 +254,112 @@ func BufferAddUTF16(buffer Buffer, text []uint16) {
 	C.hb_buffer_add_utf16(buffer, (*C.ushort)(&text[0]), C.int(len(text)), 0, C.int(len(text)))
 }
 
+// BufferAddUTF8 appends text to the buffer. The text is passed to HarfBuzz
+// without being copied.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-add-utf8
 func BufferAddUTF8(buffer Buffer, text string) {
-	cText := C.CString(text)
-	defer C.free(unsafe.Pointer(cText))
+	C.hb_buffer_add_utf8(buffer, cString(text), C.int(len(text)), 0, -1)
+}
+
+// BufferAddUTF8Bytes appends the UTF-8 encoded text to the buffer. The text is
+// passed to HarfBuzz without being copied.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-add-utf8
+func BufferAddUTF8Bytes(buffer Buffer, text []byte) {
+	C.hb_buffer_add_utf8(buffer, cBytes(text), C.int(len(text)), 0, -1)
+}
+
+// BufferAddUTF8Item appends the item of text starting at itemOffset and
+// spanning itemLength bytes to the buffer. The rest of text is used as the
+// pre- and post-context of the item, which is needed for shaping a run of a
+// paragraph correctly, e.g. for Arabic joining. A negative itemLength means
+// the end of text. The clusters are the byte offsets in the whole text. It
+// panics if the item is out of the range of text.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-add-utf8
+func BufferAddUTF8Item(buffer Buffer, text string, itemOffset, itemLength int) {
+	checkItem(len(text), itemOffset, itemLength)
 
-	C.hb_buffer_add_utf8(buffer, cText, -1, 0, -1)
+	C.hb_buffer_add_utf8(buffer, cString(text), C.int(len(text)), C.uint(itemOffset), C.int(itemLength))
 }
 
+// BufferAddUTF8BytesItem is like BufferAddUTF8Item, but takes the UTF-8
+// encoded text as a byte slice.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-add-utf8
+func BufferAddUTF8BytesItem(buffer Buffer, text []byte, itemOffset, itemLength int) {
+	checkItem(len(text), itemOffset, itemLength)
+
+	C.hb_buffer_add_utf8(buffer, cBytes(text), C.int(len(text)), C.uint(itemOffset), C.int(itemLength))
+}
+
+// BufferAddUTF16Item appends the item of text starting at itemOffset and
+// spanning itemLength code units to the buffer, using the rest of text as the
+// item's context. A negative itemLength means the end of text. The clusters are
+// the code unit offsets in the whole text. It panics if the item is out
+// of the range of text.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-add-utf16
+func BufferAddUTF16Item(buffer Buffer, text []uint16, itemOffset, itemLength int) {
+	checkItem(len(text), itemOffset, itemLength)
+
+	if len(text) == 0 {
+		return
+	}
+
+	C.hb_buffer_add_utf16(buffer, (*C.ushort)(&text[0]), C.int(len(text)), C.uint(itemOffset), C.int(itemLength))
+}
+
+// BufferAddUTF32Item appends the item of text starting at itemOffset and
+// spanning itemLength code units to the buffer, using the rest of text as the
+// item's context. A negative itemLength means the end of text. The clusters are
+// the indices in the whole text. It panics if the item is out
+// of the range of text.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-add-utf32
+func BufferAddUTF32Item(buffer Buffer, text []uint32, itemOffset, itemLength int) {
+	checkItem(len(text), itemOffset, itemLength)
+
+	if len(text) == 0 {
+		return
+	}
+
+	C.hb_buffer_add_utf32(buffer, (*C.uint)(&text[0]), C.int(len(text)), C.uint(itemOffset), C.int(itemLength))
+}
+
+// BufferAddCodepointsItem appends the item of text starting at itemOffset and
+// spanning itemLength codepoints to the buffer, using the rest of text as the
+// item's context. A negative itemLength means the end of text. The clusters are
+// the indices in the whole text. It panics if the item is out
+// of the range of text.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-add-codepoints
+func BufferAddCodepointsItem(buffer Buffer, text []Codepoint, itemOffset, itemLength int) {
+	checkItem(len(text), itemOffset, itemLength)
+
+	if len(text) == 0 {
+		return
+	}
+
+	C.hb_buffer_add_codepoints(buffer, (*C.uint)(&text[0]), C.int(len(text)), C.uint(itemOffset), C.int(itemLength))
+}
+
+// checkItem panics, the same way slicing text would, if the item starting at
+// itemOffset and spanning itemLength units is not within a text of textLength
+// units. HarfBuzz doesn't check it, and would read past the end of the text.
+func checkItem(textLength, itemOffset, itemLength int) {
+	if itemOffset < 0 || itemOffset > textLength || (itemLength >= 0 && itemLength > textLength-itemOffset) {
+		panic(fmt.Sprintf("hb: item [%d:%d] out of range with length %d", itemOffset, itemOffset+itemLength, textLength))
+	}
+}
+
+// BufferAddLatin1 appends the ISO-8859-1 (Latin-1) encoded text to the buffer.
+// The text is passed to HarfBuzz without being copied.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-add-latin1
 func BufferAddLatin1(buffer Buffer, text []byte) {
-	C.hb_buffer_add_utf8(buffer, (*C.char)(unsafe.Pointer(&text[0])), C.int(len(text)), 0, C.int(len(text)))
+	C.hb_buffer_add_latin1(buffer, (*C.uint8_t)(unsafe.Pointer(cBytes(text))), C.int(len(text)), 0, -1)
 }
 
 // BufferAppend appends part of the src buffer to the dst buffer.
@@ -358,23 +456,35 @@ func BufferGuessSegmentProperties(buffer Buffer) {
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-set-unicode-funcs
-// TODO: C.hb_buffer_set_unicode_funcs
+func BufferSetUnicodeFuncs(buffer Buffer, unicodeFuncs UnicodeFuncs) {
+	C.hb_buffer_set_unicode_funcs(buffer, unicodeFuncs)
+}
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-get-unicode-funcs
-// TODO: C.hb_buffer_get_unicode_funcs
+func BufferGetUnicodeFuncs(buffer Buffer) UnicodeFuncs {
+	return C.hb_buffer_get_unicode_funcs(buffer)
+}
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-get-glyph-infos
 func BufferGetGlyphInfos(buffer Buffer) []GlyphInfo {
-	var length uint32
-	data := C.hb_buffer_get_glyph_infos(buffer, (*C.uint)(&length))
-
-	res := make([]GlyphInfo, length)
-	size := unsafe.Sizeof(C.hb_glyph_info_t{})
+	return append([]GlyphInfo(nil), BufferGetGlyphInfosView(buffer)...)
+}
 
-	for i := uint32(0); i < length; i++ {
-		res[i] = *(*GlyphInfo)(unsafe.Pointer(uintptr(unsafe.Pointer(data)) + size*uintptr(i)))
+// BufferGetGlyphInfosView is like BufferGetGlyphInfos, but returns a slice
+// backed by the buffer's own memory instead of a copy.
+//
+// The slice is only valid until the buffer is modified (e.g. by adding text,
+// shaping, clearing or resetting it) or destroyed. It must not be appended to.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-get-glyph-infos
+func BufferGetGlyphInfosView(buffer Buffer) []GlyphInfo {
+	var length C.uint
+	data := C.hb_buffer_get_glyph_infos(buffer, &length)
+	if data == nil || length == 0 {
+		return nil
 	}
-	return res
+
+	return unsafe.Slice((*GlyphInfo)(unsafe.Pointer(data)), length)
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-glyph-info-get-glyph-flags
@@ -384,16 +494,38 @@ func GlyphInfoGetGlyphFlags(info GlyphInfo) GlyphFlags {
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-get-glyph-positions
 func BufferGetGlyphPositions(buffer Buffer) []GlyphPosition {
-	var length uint32
-	data := C.hb_buffer_get_glyph_positions(buffer, (*C.uint)(&length))
+	return append([]GlyphPosition(nil), BufferGetGlyphPositionsView(buffer)...)
+}
+
+// BufferGetGlyphPositionsView is like BufferGetGlyphPositions, but returns a
+// slice backed by the buffer's own memory instead of a copy.
+//
+// The slice is only valid until the buffer is modified (e.g. by adding text,
+// shaping, clearing or resetting it) or destroyed. It must not be appended to.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-get-glyph-positions
+func BufferGetGlyphPositionsView(buffer Buffer) []GlyphPosition {
+	var length C.uint
+	data := C.hb_buffer_get_glyph_positions(buffer, &length)
+	if data == nil || length == 0 {
+		return nil
+	}
 
-	res := make([]GlyphPosition, length)
-	size := unsafe.Sizeof(C.hb_glyph_position_t{})
+	return unsafe.Slice((*GlyphPosition)(unsafe.Pointer(data)), length)
+}
+
+// BufferGetGlyphs returns copies of the glyph infos and positions of the
+// buffer, along with the flags of every glyph, in a single call.
+func BufferGetGlyphs(buffer Buffer) (infos []GlyphInfo, positions []GlyphPosition, flags []GlyphFlags) {
+	infos = BufferGetGlyphInfos(buffer)
+	positions = BufferGetGlyphPositions(buffer)
 
-	for i := uint32(0); i < length; i++ {
-		res[i] = *(*GlyphPosition)(unsafe.Pointer(uintptr(unsafe.Pointer(data)) + size*uintptr(i)))
+	flags = make([]GlyphFlags, len(infos))
+	for i, info := range infos {
+		flags[i] = GlyphFlags(info.mask) & GlyphFlagDefined
 	}
-	return res
+
+	return infos, positions, flags
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-has-positions
@@ -453,33 +585,139 @@ func BufferReverseClusters(buffer Buffer) {
 	C.hb_buffer_reverse_clusters(buffer)
 }
 
+// BufferSerialize serializes the whole buffer into a textual representation
+// of its content, whether Unicode codepoints or glyph identifiers and positioning
+// information. font is used to look up glyph names and extents, and may be nil.
+// It returns an empty string if format is not a valid SerializeFormat.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-serialize
-// TODO: C.hb_buffer_serialize
+func BufferSerialize(buffer Buffer, font Font, format SerializeFormat, flags SerializeFlags) string {
+	return bufferSerialize(buffer, 0, BufferGetLength(buffer), format, func(start, end C.uint, buf *C.char, bufSize C.uint, bufConsumed *C.uint) C.uint {
+		return C.hb_buffer_serialize(buffer, start, end, buf, bufSize, bufConsumed, font, C.hb_buffer_serialize_format_t(format), C.hb_buffer_serialize_flags_t(flags))
+	})
+}
 
+// BufferSerializeGlyphs serializes the glyphs in the range [start, end) of the
+// buffer into a textual representation. font is used to look up glyph names and
+// extents, and may be nil. It returns an empty string if format is not a valid
+// SerializeFormat.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-serialize-glyphs
-// TODO: C.hb_buffer_serialize_glyphs
+func BufferSerializeGlyphs(buffer Buffer, start, end uint32, font Font, format SerializeFormat, flags SerializeFlags) string {
+	return bufferSerialize(buffer, start, end, format, func(start, end C.uint, buf *C.char, bufSize C.uint, bufConsumed *C.uint) C.uint {
+		return C.hb_buffer_serialize_glyphs(buffer, start, end, buf, bufSize, bufConsumed, font, C.hb_buffer_serialize_format_t(format), C.hb_buffer_serialize_flags_t(flags))
+	})
+}
 
+// BufferDeserializeGlyphs deserializes glyphs from the textual representation
+// of str and appends them to the buffer. It returns false if str couldn't be
+// fully parsed.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-deserialize-glyphs
-// TODO: C.hb_buffer_deserialize_glyphs
+func BufferDeserializeGlyphs(buffer Buffer, str string, font Font, format SerializeFormat) bool {
+	return C.hb_buffer_deserialize_glyphs(buffer, cString(str), C.int(len(str)), nil, font, C.hb_buffer_serialize_format_t(format)) == 1
+}
 
+// BufferSerializeUnicode serializes the Unicode codepoints in the range
+// [start, end) of the buffer into a textual representation. It returns an empty
+// string if format is not a valid SerializeFormat.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-serialize-unicode
-// TODO: C.hb_buffer_serialize_unicode
+func BufferSerializeUnicode(buffer Buffer, start, end uint32, format SerializeFormat, flags SerializeFlags) string {
+	return bufferSerialize(buffer, start, end, format, func(start, end C.uint, buf *C.char, bufSize C.uint, bufConsumed *C.uint) C.uint {
+		return C.hb_buffer_serialize_unicode(buffer, start, end, buf, bufSize, bufConsumed, C.hb_buffer_serialize_format_t(format), C.hb_buffer_serialize_flags_t(flags))
+	})
+}
 
+// BufferDeserializeUnicode deserializes Unicode codepoints from the textual
+// representation of str and appends them to the buffer. It returns false if str
+// couldn't be fully parsed.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-deserialize-unicode
-// TODO: C.hb_buffer_deserialize_unicode
+func BufferDeserializeUnicode(buffer Buffer, str string, format SerializeFormat) bool {
+	return C.hb_buffer_deserialize_unicode(buffer, cString(str), C.int(len(str)), nil, C.hb_buffer_serialize_format_t(format)) == 1
+}
+
+// maxSerializeItem is the scratch buffer size bufferSerialize stops growing at.
+// HarfBuzz formats each item in a 1024 byte scratch buffer of its own, so an
+// item that doesn't fit in this many bytes never will.
+const maxSerializeItem = 4096
+
+// bufferSerialize calls serialize until all the items in [start, end) are
+// written, growing the scratch buffer whenever a single item doesn't fit in it.
+// It returns an empty string for formats other than SerializeFormatText and
+// SerializeFormatJson, for which HarfBuzz writes nothing. serialize is called
+// at least once, so an empty buffer gets the "!!" or "[]" HarfBuzz writes for it.
+func bufferSerialize(buffer Buffer, start, end uint32, format SerializeFormat, serialize func(start, end C.uint, buf *C.char, bufSize C.uint, bufConsumed *C.uint) C.uint) string {
+	if format != SerializeFormatText && format != SerializeFormatJson {
+		return ""
+	}
 
+	if length := BufferGetLength(buffer); end > length {
+		end = length
+	}
+
+	buf := make([]byte, 1024)
+	res := make([]byte, 0, len(buf))
+
+	for {
+		var consumed C.uint
+		n := serialize(C.uint(start), C.uint(end), (*C.char)(unsafe.Pointer(&buf[0])), C.uint(len(buf)), &consumed)
+		res = append(res, buf[:consumed]...)
+
+		start += uint32(n)
+		if start >= end {
+			break
+		}
+
+		if n == 0 {
+			if len(buf) >= maxSerializeItem {
+				break
+			}
+			buf = make([]byte, len(buf)*2)
+		}
+	}
+
+	return string(res)
+}
+
+// BufferSerializeFormatFromString parses a string into a SerializeFormat. It
+// returns SerializeFormatInvalid if the string is not a known format.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-serialize-format-from-string
-// TODO: C.hb_buffer_serialize_format_from_string
+func BufferSerializeFormatFromString(str string) SerializeFormat {
+	cStr := C.CString(str)
+	defer C.free(unsafe.Pointer(cStr))
 
+	return SerializeFormat(C.hb_buffer_serialize_format_from_string(cStr, -1))
+}
+
+// BufferSerializeFormatToString converts format to the string corresponding it,
+// or an empty string if it is not a valid SerializeFormat.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-serialize-format-to-string
-// TODO: C.hb_buffer_serialize_format_to_string
+func BufferSerializeFormatToString(format SerializeFormat) string {
+	return C.GoString(C.hb_buffer_serialize_format_to_string(C.hb_buffer_serialize_format_t(format)))
+}
 
+// BufferSerializeListFormats returns the list of supported serialization formats.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-serialize-list-formats
-// TODO: C.hb_buffer_serialize_list_formats
+func BufferSerializeListFormats() (formats []string) {
+	res := C.hb_buffer_serialize_list_formats()
+
+	for data := *res; data != nil; data = *res {
+		formats = append(formats, C.GoString(data))
+
+		res = (**C.char)(unsafe.Pointer(uintptr(unsafe.Pointer(res)) + unsafe.Sizeof(res)))
+	}
+
+	return formats
+}
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-segment-properties-equal
 func SegmentPropertiesEqual(a, b *SegmentProperties) bool {
-	return C.hb_segment_properties_equal((*C.hb_segment_properties_t)(unsafe.Pointer(a)), (*C.hb_segment_properties_t)(unsafe.Pointer(a))) == 1
+	return C.hb_segment_properties_equal((*C.hb_segment_properties_t)(unsafe.Pointer(a)), (*C.hb_segment_properties_t)(unsafe.Pointer(b))) == 1
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-segment-properties-hash
@@ -497,10 +735,28 @@ func BufferDiff(buffer, reference Buffer, dottedCircleGlyph Codepoint, positionF
 	return BufferDiffFlags(C.hb_buffer_diff(buffer, reference, C.uint(dottedCircleGlyph), C.uint(positionFuzz)))
 }
 
+// MessageFunc is the callback invoked by HarfBuzz to report the shaping
+// progress, such as the start and end of each lookup. Returning false stops
+// the shaping process.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-message-func-t
-type MessageFunc C.hb_buffer_message_func_t
+type MessageFunc func(buffer Buffer, font Font, message string) bool
 
+// BufferSetMessageFunc sets the callback which HarfBuzz calls to report the
+// shaping progress on the buffer. Passing a nil f removes the callback.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-buffer.html#hb-buffer-set-message-func
-func BufferSetMessageFunc(buffer Buffer, f MessageFunc, userData unsafe.Pointer, destroy DestroyFunc) {
-	C.hb_buffer_set_message_func(buffer, f, userData, destroy)
+func BufferSetMessageFunc(buffer Buffer, f MessageFunc) {
+	if f == nil {
+		C.hb_buffer_set_message_func(buffer, nil, nil, nil)
+		return
+	}
+
+	C.hb_buffer_set_message_func(buffer, C.hb_buffer_message_func_t(C.goBufferMessageFunc), cHandle(f), cDestroyHandle)
+}
+
+//export goBufferMessageFunc
+func goBufferMessageFunc(buffer *C.hb_buffer_t, font *C.hb_font_t, message *C.char, userData unsafe.Pointer) C.hb_bool_t {
+	f := handleValue(userData).(MessageFunc)
+	return C.hb_bool_t(cBool(f(buffer, font, C.GoString(message))))
 }
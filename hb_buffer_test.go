@@ -0,0 +1,73 @@
+package hb
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBufferSerializeInvalidFormat(t *testing.T) {
+	buffer := BufferCreate()
+	defer BufferDestroy(buffer)
+
+	BufferAddUTF8(buffer, "Hello")
+
+	for _, format := range []SerializeFormat{SerializeFormatInvalid, BufferSerializeFormatFromString("bogus")} {
+		if got := BufferSerialize(buffer, nil, format, SerializeFlagDefault); got != "" {
+			t.Errorf("BufferSerialize with format %#x: got %q, want an empty string", format, got)
+		}
+		if got := BufferSerializeUnicode(buffer, 0, 5, format, SerializeFlagDefault); got != "" {
+			t.Errorf("BufferSerializeUnicode with format %#x: got %q, want an empty string", format, got)
+		}
+	}
+
+	BufferGuessSegmentProperties(buffer)
+	Shape(testFont(t, "HB_TEST_FONT"), buffer, nil)
+	if got := BufferSerializeGlyphs(buffer, 0, 5, nil, SerializeFormatInvalid, SerializeFlagDefault); got != "" {
+		t.Errorf("BufferSerializeGlyphs with SerializeFormatInvalid: got %q, want an empty string", got)
+	}
+}
+
+func TestBufferSerializeEmpty(t *testing.T) {
+	buffer := BufferCreate()
+	defer BufferDestroy(buffer)
+
+	if got := BufferSerialize(buffer, nil, SerializeFormatText, SerializeFlagDefault); got != "!!" {
+		t.Errorf("got %q for SerializeFormatText, want %q", got, "!!")
+	}
+	if got := BufferSerialize(buffer, nil, SerializeFormatJson, SerializeFlagDefault); got != "[]" {
+		t.Errorf("got %q for SerializeFormatJson, want %q", got, "[]")
+	}
+}
+
+func TestBufferSerializeUnicode(t *testing.T) {
+	// Long enough to need more than one call with the initial scratch buffer.
+	text := strings.Repeat("Hello, World! ", 200)
+
+	buffer := BufferCreate()
+	defer BufferDestroy(buffer)
+
+	BufferAddUTF8(buffer, text)
+
+	for _, format := range []SerializeFormat{SerializeFormatText, SerializeFormatJson} {
+		str := BufferSerializeUnicode(buffer, 0, BufferGetLength(buffer)+10, format, SerializeFlagDefault)
+
+		parsed := BufferCreate()
+		if !BufferDeserializeUnicode(parsed, str, format) {
+			t.Errorf("failed to deserialize %q", str)
+		}
+
+		want, got := BufferGetGlyphInfos(buffer), BufferGetGlyphInfos(parsed)
+		if len(got) != len(want) {
+			t.Errorf("format %#x: got %d codepoints back, want %d", format, len(got), len(want))
+		} else {
+			for i := range want {
+				if got[i].Codepoint != want[i].Codepoint || got[i].Cluster != want[i].Cluster {
+					t.Errorf("format %#x: got codepoint %d at %d, want %d", format, got[i].Codepoint, i, want[i].Codepoint)
+					break
+				}
+			}
+		}
+
+		BufferDestroy(parsed)
+	}
+}
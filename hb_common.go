@@ -211,6 +211,11 @@ type UserDataKey C.hb_user_data_key_t
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-common.html#hb-language-t
 type Language C.hb_language_t
 
+const (
+	FeatureGlobalStart = C.HB_FEATURE_GLOBAL_START // Special setting for Feature.Start to apply the feature from the start of the buffer.
+	FeatureGlobalEnd   = C.HB_FEATURE_GLOBAL_END   // Special setting for Feature.End to apply the feature to the end of the buffer.
+)
+
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-common.html#hb-feature-t
 type Feature struct {
 	Tag   Tag
@@ -266,6 +271,51 @@ func DirectionToString(direction Direction) string {
 	return C.GoString(C.hb_direction_to_string(C.hb_direction_t(direction)))
 }
 
+// DirectionIsValid tests whether a Direction is valid.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-common.html#HB-DIRECTION-IS-VALID:CAPS
+func DirectionIsValid(direction Direction) bool {
+	return direction&^3 == 4
+}
+
+// DirectionIsHorizontal tests whether a Direction is horizontal.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-common.html#HB-DIRECTION-IS-HORIZONTAL:CAPS
+func DirectionIsHorizontal(direction Direction) bool {
+	return direction&^1 == 4
+}
+
+// DirectionIsVertical tests whether a Direction is vertical.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-common.html#HB-DIRECTION-IS-VERTICAL:CAPS
+func DirectionIsVertical(direction Direction) bool {
+	return direction&^1 == 6
+}
+
+// DirectionIsForward tests whether a Direction moves forward, from beginning
+// to end (left to right or top to bottom).
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-common.html#HB-DIRECTION-IS-FORWARD:CAPS
+func DirectionIsForward(direction Direction) bool {
+	return direction&^2 == 4
+}
+
+// DirectionIsBackward tests whether a Direction moves backward, from end to
+// beginning (right to left or bottom to top).
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-common.html#HB-DIRECTION-IS-BACKWARD:CAPS
+func DirectionIsBackward(direction Direction) bool {
+	return direction&^2 == 5
+}
+
+// DirectionReverse reverses a text Direction. Requires that the direction be
+// valid.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-common.html#HB-DIRECTION-REVERSE:CAPS
+func DirectionReverse(direction Direction) Direction {
+	return direction ^ 1
+}
+
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-common.html#hb-script-from-iso15924-tag
 func ScriptFromISO15924Tag(tag Tag) Script {
 	return Script(C.hb_script_from_iso15924_tag(*(*C.hb_tag_t)(unsafe.Pointer(&tag))))
@@ -2,6 +2,8 @@ package hb
 
 // #include <stdlib.h>
 // #include <hb.h>
+//
+// extern hb_blob_t *goReferenceTableFunc(hb_face_t *, hb_tag_t, void *);
 import "C"
 import "unsafe"
 
@@ -20,9 +22,40 @@ func FaceCreate(blob Blob, index uint32) Face {
 	return C.hb_face_create(blob, C.uint(index))
 }
 
+// TableProvider provides the raw data of the tables of a font face.
+type TableProvider interface {
+	// Table returns the data of the table with the given tag, or nil if the
+	// face doesn't have such table. The data is copied by HarfBuzz, so the
+	// returned slice may be reused or modified afterwards.
+	Table(tag Tag) []byte
+}
+
+// TableMap is a TableProvider holding the tables in a map.
+//
+// Tags must be created using TagFromString to match the ones HarfBuzz asks for.
+type TableMap map[Tag][]byte
+
+// Table returns the table with the given tag from m.
+func (m TableMap) Table(tag Tag) []byte {
+	return m[tag]
+}
+
+// FaceCreateForTables creates a new face whose tables are lazily fetched from
+// provider when HarfBuzz needs them.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-face.html#hb-face-create-for-tables
-func FaceCreateForTables(referenceTableFunc ReferenceTableFunc, userData unsafe.Pointer, destroy DestroyFunc) Face {
-	return C.hb_face_create_for_tables(referenceTableFunc, userData, destroy)
+func FaceCreateForTables(provider TableProvider) Face {
+	return C.hb_face_create_for_tables(C.hb_reference_table_func_t(C.goReferenceTableFunc), cHandle(provider), cDestroyHandle)
+}
+
+//export goReferenceTableFunc
+func goReferenceTableFunc(face *C.hb_face_t, tag C.hb_tag_t, userData unsafe.Pointer) *C.hb_blob_t {
+	data := handleValue(userData).(TableProvider).Table(*(*Tag)(unsafe.Pointer(&tag)))
+	if len(data) == 0 {
+		return C.hb_blob_get_empty()
+	}
+
+	return C.hb_blob_create((*C.char)(unsafe.Pointer(&data[0])), C.uint(len(data)), C.HB_MEMORY_MODE_DUPLICATE, nil, nil)
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-face.html#hb-face-get-empty
@@ -60,11 +93,25 @@ func FaceIsImmutable(face Face) bool {
 	return C.hb_face_is_immutable(face) == 1
 }
 
-// TODO:
+// FaceGetTableTags fetches all of the table tags of the face.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-face.html#hb-face-get-table-tags
-// func FaceGetTableTags(face Face, startOffset uint32) (uint32) {
-// 	return uint32(C.hb_face_get_table_tags(face, C.uint(startOffset)))
-// }
+func FaceGetTableTags(face Face) (tags []Tag) {
+	var page [64]Tag
+
+	for offset := C.uint(0); ; {
+		count := C.uint(len(page))
+		total := C.hb_face_get_table_tags(face, offset, &count, (*C.hb_tag_t)(unsafe.Pointer(&page[0])))
+		tags = append(tags, page[:count]...)
+
+		offset += count
+		if count == 0 || offset >= total {
+			break
+		}
+	}
+
+	return tags
+}
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-face.html#hb-face-set-glyph-count
 func FaceSetGlyphCount(face Face, glyphCount uint32) {
@@ -111,11 +158,64 @@ func FaceCollectUnicodes(face Face, out Set) {
 	C.hb_face_collect_unicodes(face, out)
 }
 
-// TODO:
+// FaceCollectNominalGlyphMapping collects the mapping from Unicode characters
+// to nominal glyphs of the face.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-face.html#hb-face-collect-nominal-glyph-mapping
-// func FaceCollectNominalGlyphMapping(face Face) {
-// 	C.hb_face_collect_nominal_glyph_mapping()
-// }
+func FaceCollectNominalGlyphMapping(face Face) map[rune]Codepoint {
+	mapping := C.hb_map_create()
+	defer C.hb_map_destroy(mapping)
+
+	C.hb_face_collect_nominal_glyph_mapping(face, mapping, nil)
+
+	res := make(map[rune]Codepoint, C.hb_map_get_population(mapping))
+
+	idx := C.int(-1)
+	var key, value C.hb_codepoint_t
+	for C.hb_map_next(mapping, &idx, &key, &value) == 1 {
+		res[rune(key)] = Codepoint(value)
+	}
+
+	return res
+}
+
+// VariationSequence is a Unicode character followed by a variation selector.
+type VariationSequence struct {
+	Unicode  rune
+	Selector rune
+}
+
+// FaceCollectVariationGlyphMapping collects the mapping from variation
+// sequences to glyphs of the face, for all of the variation selectors reported
+// by FaceCollectVariationSelectors.
+func FaceCollectVariationGlyphMapping(face Face) map[VariationSequence]Codepoint {
+	res := make(map[VariationSequence]Codepoint)
+
+	selectors := SetCreate()
+	defer SetDestroy(selectors)
+
+	unicodes := SetCreate()
+	defer SetDestroy(unicodes)
+
+	font := FontCreate(face)
+	defer FontDestroy(font)
+
+	FaceCollectVariationSelectors(face, selectors)
+
+	for selector, ok := SetNext(selectors, SetValueInvalid); ok; selector, ok = SetNext(selectors, selector) {
+		SetClear(unicodes)
+		FaceCollectVariationUnicodes(face, selector, unicodes)
+
+		for unicode, ok := SetNext(unicodes, SetValueInvalid); ok; unicode, ok = SetNext(unicodes, unicode) {
+			var glyph C.hb_codepoint_t
+			if C.hb_font_get_variation_glyph(font, C.hb_codepoint_t(unicode), C.hb_codepoint_t(selector), &glyph) == 1 {
+				res[VariationSequence{Unicode: rune(unicode), Selector: rune(selector)}] = Codepoint(glyph)
+			}
+		}
+	}
+
+	return res
+}
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-face.html#hb-face-collect-variation-selectors
 func FaceCollectVariationSelectors(face Face, out Set) {
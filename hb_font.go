@@ -2,6 +2,18 @@ package hb
 
 // #include <stdlib.h>
 // #include <hb.h>
+//
+// static hb_user_data_key_t hb_go_font_change_func_key;
+//
+// static void hb_go_font_get_glyph_origins_for_direction(hb_font_t *font, unsigned int count, const hb_codepoint_t *glyphs, hb_direction_t direction, hb_position_t *x, hb_position_t *y) {
+// 	for (unsigned int i = 0; i < count; i++)
+// 		hb_font_get_glyph_origin_for_direction(font, glyphs[i], direction, &x[i], &y[i]);
+// }
+//
+// static void hb_go_font_get_glyph_kernings_for_direction(hb_font_t *font, unsigned int count, const hb_codepoint_t *glyphs, hb_direction_t direction, hb_position_t *x, hb_position_t *y) {
+// 	for (unsigned int i = 0; i + 1 < count; i++)
+// 		hb_font_get_glyph_kerning_for_direction(font, glyphs[i], glyphs[i + 1], direction, &x[i], &y[i]);
+// }
 import "C"
 import "unsafe"
 
@@ -25,7 +37,16 @@ type GlyphExtents struct {
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-add-glyph-origin-for-direction
 func FontAddGlyphOriginForDirection(font Font, glyph uint32, direction Direction, x, y *int) {
-	C.hb_font_add_glyph_origin_for_direction(font, C.uint(glyph), C.hb_direction_t(direction), (*C.int)(unsafe.Pointer(x)), (*C.int)(unsafe.Pointer(y)))
+	cX, cY := C.int(*x), C.int(*y)
+	C.hb_font_add_glyph_origin_for_direction(font, C.uint(glyph), C.hb_direction_t(direction), &cX, &cY)
+	*x, *y = int(cX), int(cY)
+}
+
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-subtract-glyph-origin-for-direction
+func FontSubtractGlyphOriginForDirection(font Font, glyph uint32, direction Direction, x, y *int) {
+	cX, cY := C.int(*x), C.int(*y)
+	C.hb_font_subtract_glyph_origin_for_direction(font, C.uint(glyph), C.hb_direction_t(direction), &cX, &cY)
+	*x, *y = int(cX), int(cY)
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-create
@@ -76,6 +97,7 @@ func FontIsImmutable(font Font) bool {
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-set-face
 func FontSetFace(font Font, face Face) {
 	C.hb_font_set_face(font, face)
+	fontNotifyChange(font)
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-face
@@ -95,12 +117,20 @@ func FontGetGlyphAdvanceForDirection(font Font, glyph Codepoint, direction Direc
 	return
 }
 
-// TODO:
+// FontGetGlyphAdvancesForDirection fetches the advances of all of the glyphs
+// along the specified direction in a single call.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-glyph-advances-for-direction
-// func FontGetGlyphAdvancesForDirection(font Font, glyph Codepoint, direction Direction, firstGlyph Codepoint, glyphStride uint32) (firstAdvance int32, advanceStride uint32) {
-// 	C.hb_font_get_glyph_advances_for_direction(font, C.hb_direction_t(direction), (*C.uint)(&firstGlyph), C.uint(glyphStride), (*C.int)(&firstAdvance), (*C.uint)(&advanceStride))
-// 	return
-// }
+func FontGetGlyphAdvancesForDirection(font Font, direction Direction, glyphs []Codepoint) []int32 {
+	if len(glyphs) == 0 {
+		return nil
+	}
+
+	advances := make([]int32, len(glyphs))
+	C.hb_font_get_glyph_advances_for_direction(font, C.hb_direction_t(direction), C.uint(len(glyphs)), (*C.hb_codepoint_t)(&glyphs[0]), 4, (*C.hb_position_t)(&advances[0]), 4)
+
+	return advances
+}
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-glyph-contour-point
 func FontGetGlyphContourPoint(font Font, glyph Codepoint, pointIndex uint32) (x, y int32, ok bool) {
@@ -126,71 +156,158 @@ func FontGetGlyphExtentsForOrigin(font Font, glyph Codepoint, direction Directio
 	return
 }
 
-// TODO:
+// FontGetGlyphFromName fetches the glyph ID that corresponds to a name string.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-glyph-from-name
-// func FontGetGlyphFromName() {
-// 	return C.hb_font_get_glyph_from_name()
-// }
+func FontGetGlyphFromName(font Font, name string) (glyph Codepoint, ok bool) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	ok = C.hb_font_get_glyph_from_name(font, cName, C.int(len(name)), (*C.uint)(&glyph)) == 1
+	return
+}
 
-// TODO:
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-glyph-h-advance
-// func FontGetGlyphHAdvance() {
-// 	return C.hb_font_get_glyph_h_advance()
-// }
+func FontGetGlyphHAdvance(font Font, glyph Codepoint) int32 {
+	return int32(C.hb_font_get_glyph_h_advance(font, C.uint(glyph)))
+}
 
-// TODO:
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-glyph-v-advance
-// func FontGetGlyphVAdvance() {
-// 	return C.hb_font_get_glyph_v_advance()
-// }
+func FontGetGlyphVAdvance(font Font, glyph Codepoint) int32 {
+	return int32(C.hb_font_get_glyph_v_advance(font, C.uint(glyph)))
+}
 
-// TODO:
+// FontGetGlyphHAdvances fetches the horizontal advances of all of the glyphs in
+// a single call.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-glyph-h-advances
-// func FontGetGlyphHAdvances() {
-// 	return C.hb_font_get_glyph_h_advances()
-// }
+func FontGetGlyphHAdvances(font Font, glyphs []Codepoint) []int32 {
+	if len(glyphs) == 0 {
+		return nil
+	}
 
-// TODO:
+	advances := make([]int32, len(glyphs))
+	C.hb_font_get_glyph_h_advances(font, C.uint(len(glyphs)), (*C.hb_codepoint_t)(&glyphs[0]), 4, (*C.hb_position_t)(&advances[0]), 4)
+
+	return advances
+}
+
+// FontGetGlyphVAdvances fetches the vertical advances of all of the glyphs in
+// a single call.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-glyph-v-advances
-// func FontGetGlyphVAdvances() {
-// 	return C.hb_font_get_glyph_v_advances()
-// }
+func FontGetGlyphVAdvances(font Font, glyphs []Codepoint) []int32 {
+	if len(glyphs) == 0 {
+		return nil
+	}
+
+	advances := make([]int32, len(glyphs))
+	C.hb_font_get_glyph_v_advances(font, C.uint(len(glyphs)), (*C.hb_codepoint_t)(&glyphs[0]), 4, (*C.hb_position_t)(&advances[0]), 4)
+
+	return advances
+}
 
-// TODO:
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-glyph-h-kerning
-// func FontGetGlyphHKerning() {
-// 	return C.hb_font_get_glyph_h_kerning()
-// }
+func FontGetGlyphHKerning(font Font, leftGlyph, rightGlyph Codepoint) int32 {
+	return int32(C.hb_font_get_glyph_h_kerning(font, C.uint(leftGlyph), C.uint(rightGlyph)))
+}
 
-// TODO:
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-glyph-kerning-for-direction
-// func FontGetGlyphKerningForDirection() {
-// 	return C.hb_font_get_glyph_kerning_for_direction()
-// }
+func FontGetGlyphKerningForDirection(font Font, firstGlyph, secondGlyph Codepoint, direction Direction) (x, y int32) {
+	C.hb_font_get_glyph_kerning_for_direction(font, C.uint(firstGlyph), C.uint(secondGlyph), C.hb_direction_t(direction), (*C.int)(&x), (*C.int)(&y))
+	return
+}
+
+// FontGetGlyphKerningsForDirection fetches the kerning between every pair of
+// adjacent glyphs along the specified direction in a single call. The kerning
+// between glyphs[i] and glyphs[i+1] is stored at index i.
+func FontGetGlyphKerningsForDirection(font Font, glyphs []Codepoint, direction Direction) (x, y []int32) {
+	if len(glyphs) < 2 {
+		return nil, nil
+	}
+
+	x, y = make([]int32, len(glyphs)-1), make([]int32, len(glyphs)-1)
+	C.hb_go_font_get_glyph_kernings_for_direction(font, C.uint(len(glyphs)), (*C.hb_codepoint_t)(&glyphs[0]), C.hb_direction_t(direction), (*C.hb_position_t)(&x[0]), (*C.hb_position_t)(&y[0]))
+
+	return x, y
+}
 
-// TODO:
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-glyph-h-origin
-// func FontGetGlyphHOrigin() {
-// 	return C.hb_font_get_glyph_h_origin()
-// }
+func FontGetGlyphHOrigin(font Font, glyph Codepoint) (x, y int32, ok bool) {
+	ok = C.hb_font_get_glyph_h_origin(font, C.uint(glyph), (*C.int)(&x), (*C.int)(&y)) == 1
+	return
+}
 
-// TODO:
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-glyph-v-origin
-// func FontGetGlyphVOrigin() {
-// 	return C.hb_font_get_glyph_v_origin()
-// }
+func FontGetGlyphVOrigin(font Font, glyph Codepoint) (x, y int32, ok bool) {
+	ok = C.hb_font_get_glyph_v_origin(font, C.uint(glyph), (*C.int)(&x), (*C.int)(&y)) == 1
+	return
+}
 
-// TODO:
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-glyph-origin-for-direction
-// func FontGetGlyphOriginForDirection() {
-// 	return C.hb_font_get_glyph_origin_for_direction()
-// }
+func FontGetGlyphOriginForDirection(font Font, glyph Codepoint, direction Direction) (x, y int32) {
+	C.hb_font_get_glyph_origin_for_direction(font, C.uint(glyph), C.hb_direction_t(direction), (*C.int)(&x), (*C.int)(&y))
+	return
+}
 
-// TODO:
+// FontGetGlyphOriginsForDirection fetches the origins of all of the glyphs for
+// the specified direction in a single call.
+func FontGetGlyphOriginsForDirection(font Font, glyphs []Codepoint, direction Direction) (x, y []int32) {
+	if len(glyphs) == 0 {
+		return nil, nil
+	}
+
+	x, y = make([]int32, len(glyphs)), make([]int32, len(glyphs))
+	C.hb_go_font_get_glyph_origins_for_direction(font, C.uint(len(glyphs)), (*C.hb_codepoint_t)(&glyphs[0]), C.hb_direction_t(direction), (*C.hb_position_t)(&x[0]), (*C.hb_position_t)(&y[0]))
+
+	return x, y
+}
+
+// FontGetGlyphName fetches the name of the specified glyph ID.
+//
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-glyph-name
-// func FontGetGlyphName(font Font, glyph Codepoint) {
-// 	return C.hb_font_get_glyph_name()
-// }
+func FontGetGlyphName(font Font, glyph Codepoint) (name string, ok bool) {
+	var buf [128]C.char
+	ok = C.hb_font_get_glyph_name(font, C.uint(glyph), &buf[0], C.uint(len(buf))) == 1
+	if ok {
+		name = C.GoString(&buf[0])
+	}
+	return
+}
+
+// FontGlyphToString fetches the name of the specified glyph ID. If the glyph
+// has no name, a string of the form "gidDDD" is returned instead.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-glyph-to-string
+func FontGlyphToString(font Font, glyph Codepoint) string {
+	var buf [128]C.char
+	C.hb_font_glyph_to_string(font, C.uint(glyph), &buf[0], C.uint(len(buf)))
+
+	return C.GoString(&buf[0])
+}
+
+// FontGlyphFromString fetches the glyph ID from a string, which may either be a
+// glyph name, a string of the form "gidDDD" or "uniUUUU", or a plain number.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-glyph-from-string
+func FontGlyphFromString(font Font, str string) (glyph Codepoint, ok bool) {
+	cStr := C.CString(str)
+	defer C.free(unsafe.Pointer(cStr))
+
+	ok = C.hb_font_glyph_from_string(font, cStr, C.int(len(str)), (*C.uint)(&glyph)) == 1
+	return
+}
+
+// FontGetGlyphInfoNames returns the name of every glyph in infos, such as the
+// ones returned by BufferGetGlyphInfos after shaping, using FontGlyphToString.
+func FontGetGlyphInfoNames(font Font, infos []GlyphInfo) []string {
+	names := make([]string, len(infos))
+	for i, info := range infos {
+		names[i] = FontGlyphToString(font, info.Codepoint)
+	}
+
+	return names
+}
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-draw-glyph
 func FontDrawGlyph(font Font, glyph Codepoint, dfuncs DrawFuncs, drawData unsafe.Pointer) {
@@ -203,39 +320,52 @@ func FontDrawGlyph(font Font, glyph Codepoint, dfuncs DrawFuncs, drawData unsafe
 // 	return C.hb_font_paint_glyph(font)
 // }
 
-// TODO:
-// Learn more:
-// func hb_font_get_nominal_glyph(font Font) {
-// 	return C.hb_font_get_nominal_glyph(font)
-// }
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-nominal-glyph
+func FontGetNominalGlyph(font Font, unicode Codepoint) (glyph Codepoint, ok bool) {
+	ok = C.hb_font_get_nominal_glyph(font, C.uint(unicode), (*C.uint)(&glyph)) == 1
+	return
+}
 
-// TODO:
-// Learn more:
-// func hb_font_get_nominal_glyphs(font Font) {
-// 	return C.hb_font_get_nominal_glyphs(font)
-// }
+// FontGetNominalGlyphs fetches the nominal glyphs of all of the runes in a
+// single call. It stops at the first rune which has no glyph, and returns the
+// number of runes mapped before it; the rest of glyphs are left as zero.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-nominal-glyphs
+func FontGetNominalGlyphs(font Font, runes []rune) (glyphs []Codepoint, count int) {
+	if len(runes) == 0 {
+		return nil, 0
+	}
 
-// TODO:
-// Learn more:
-// func hb_font_get_variation_glyph(font Font) {
-// 	return C.hb_font_get_variation_glyph(font)
-// }
+	glyphs = make([]Codepoint, len(runes))
+	count = int(C.hb_font_get_nominal_glyphs(font, C.uint(len(runes)), (*C.hb_codepoint_t)(unsafe.Pointer(&runes[0])), 4, (*C.hb_codepoint_t)(&glyphs[0]), 4))
 
-// TODO:
-// Learn more:
-// func hb_font_set_parent(font Font) {
-// 	return C.hb_font_set_parent(font)
-// }
+	return glyphs, count
+}
 
-// TODO:
-// Learn more:
-// func hb_font_get_parent(font Font) {
-// 	return C.hb_font_get_parent(font)
-// }
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-variation-glyph
+func FontGetVariationGlyph(font Font, unicode, variationSelector Codepoint) (glyph Codepoint, ok bool) {
+	ok = C.hb_font_get_variation_glyph(font, C.uint(unicode), C.uint(variationSelector), (*C.uint)(&glyph)) == 1
+	return
+}
+
+// FontSetParent sets the parent font of the font. Functions not implemented by
+// the font fall back to its parent.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-set-parent
+func FontSetParent(font, parent Font) {
+	C.hb_font_set_parent(font, parent)
+	fontNotifyChange(font)
+}
+
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-parent
+func FontGetParent(font Font) Font {
+	return C.hb_font_get_parent(font)
+}
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-set-ppem
 func FontSetPpem(font Font, x, y uint32) {
 	C.hb_font_set_ppem(font, C.uint(x), C.uint(y))
+	fontNotifyChange(font)
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-ppem
@@ -247,6 +377,7 @@ func FontGetPpem(font Font) (x, y uint32) {
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-set-ptem
 func FontSetPtem(font Font, ptem float32) {
 	C.hb_font_set_ptem(font, C.float(ptem))
+	fontNotifyChange(font)
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-ptem
@@ -257,6 +388,7 @@ func FontGetPtem(font Font) float32 {
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-set-scale
 func FontSetScale(font Font, x, y int32) {
 	C.hb_font_set_scale(font, C.int(x), C.int(y))
+	fontNotifyChange(font)
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-scale
@@ -265,25 +397,200 @@ func FontGetScale(font Font) (x, y int32) {
 	return
 }
 
-// TODO: hb_font_get_synthetic_bold
-// TODO: hb_font_set_synthetic_bold
-// TODO: hb_font_set_synthetic_slant
-// TODO: hb_font_get_synthetic_slant
-// TODO: hb_font_set_variations
-// TODO: hb_font_set_variation
-// TODO: hb_font_set_var_named_instance
-// TODO: hb_font_get_var_named_instance
-// TODO: hb_font_set_var_coords_design
-// TODO: hb_font_get_var_coords_design
-// TODO: hb_font_set_var_coords_normalized
-// TODO: hb_font_get_var_coords_normalized
-// TODO: hb_font_glyph_from_string
-// TODO: hb_font_glyph_to_string
-// TODO: hb_font_get_serial
-// TODO: hb_font_changed
+// FontSetSyntheticBold sets the synthetic emboldening of the font. xEmbolden
+// and yEmbolden are the strengths of the emboldening in each direction, in
+// multiples of the em size; 0.02 is a good starting point for x, and y is
+// usually the same. If inPlace is false, the glyph advances grow to accommodate
+// the emboldening; otherwise they're left untouched.
+//
+// Glyph outlines from FontDrawGlyph, and metrics such as FontGetGlyphExtents
+// and the glyph advances, reflect the synthetic emboldening.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-set-synthetic-bold
+func FontSetSyntheticBold(font Font, xEmbolden, yEmbolden float32, inPlace bool) {
+	C.hb_font_set_synthetic_bold(font, C.float(xEmbolden), C.float(yEmbolden), cBool(inPlace))
+	fontNotifyChange(font)
+}
+
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-synthetic-bold
+func FontGetSyntheticBold(font Font) (xEmbolden, yEmbolden float32, inPlace bool) {
+	var cInPlace C.hb_bool_t
+	C.hb_font_get_synthetic_bold(font, (*C.float)(&xEmbolden), (*C.float)(&yEmbolden), &cInPlace)
+	inPlace = cInPlace == 1
+	return
+}
+
+// FontSetSyntheticSlant sets the synthetic slant of the font, as a ratio of the
+// horizontal shift to the vertical distance; 0.2 is a typical italic angle.
+// Positive values slant to the right.
+//
+// Glyph outlines from FontDrawGlyph, and metrics such as FontGetGlyphExtents,
+// reflect the synthetic slant.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-set-synthetic-slant
+func FontSetSyntheticSlant(font Font, slant float32) {
+	C.hb_font_set_synthetic_slant(font, C.float(slant))
+	fontNotifyChange(font)
+}
+
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-synthetic-slant
+func FontGetSyntheticSlant(font Font) float32 {
+	return float32(C.hb_font_get_synthetic_slant(font))
+}
+
+// FontSetVariations applies a list of font-variation settings to the font.
+// Axes not included in variations are set to their default values.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-set-variations
+func FontSetVariations(font Font, variations []Variation) {
+	var cVariations *C.hb_variation_t
+	if len(variations) > 0 {
+		cVariations = (*C.hb_variation_t)(unsafe.Pointer(&variations[0]))
+	}
+
+	C.hb_font_set_variations(font, cVariations, C.uint(len(variations)))
+	fontNotifyChange(font)
+}
+
+// FontSetVariation changes the value of a single variation axis of the font,
+// keeping the other axes as they are.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-set-variation
+func FontSetVariation(font Font, tag Tag, value float32) {
+	C.hb_font_set_variation(font, *(*C.hb_tag_t)(unsafe.Pointer(&tag)), C.float(value))
+	fontNotifyChange(font)
+}
+
+// FontNoVarNamedInstance is returned by FontGetVarNamedInstance when no named
+// instance is set on the font.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#HB-FONT-NO-VAR-NAMED-INSTANCE:CAPS
+const FontNoVarNamedInstance = 0xFFFFFFFF
+
+// FontSetVarNamedInstance sets the design coordinates of the font to those of
+// the named instance at the given index.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-set-var-named-instance
+func FontSetVarNamedInstance(font Font, instanceIndex uint32) {
+	C.hb_font_set_var_named_instance(font, C.uint(instanceIndex))
+	fontNotifyChange(font)
+}
+
+// FontGetVarNamedInstance returns the index of the named instance set on the
+// font, or FontNoVarNamedInstance if there's none.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-var-named-instance
+func FontGetVarNamedInstance(font Font) uint32 {
+	return uint32(C.hb_font_get_var_named_instance(font))
+}
+
+// FontSetVarCoordsDesign applies a list of variation coordinates, in design
+// space units, to the font.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-set-var-coords-design
+func FontSetVarCoordsDesign(font Font, coords []float32) {
+	var cCoords *C.float
+	if len(coords) > 0 {
+		cCoords = (*C.float)(unsafe.Pointer(&coords[0]))
+	}
+
+	C.hb_font_set_var_coords_design(font, cCoords, C.uint(len(coords)))
+	fontNotifyChange(font)
+}
+
+// FontGetVarCoordsDesign fetches the list of variation coordinates, in design
+// space units, currently set on the font.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-var-coords-design
+func FontGetVarCoordsDesign(font Font) []float32 {
+	var length C.uint
+	coords := C.hb_font_get_var_coords_design(font, &length)
+	if coords == nil || length == 0 {
+		return nil
+	}
+
+	return append([]float32(nil), unsafe.Slice((*float32)(unsafe.Pointer(coords)), length)...)
+}
+
+// FontSetVarCoordsNormalized applies a list of variation coordinates, in
+// normalized units, to the font.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-set-var-coords-normalized
+func FontSetVarCoordsNormalized(font Font, coords []int32) {
+	var cCoords *C.int
+	if len(coords) > 0 {
+		cCoords = (*C.int)(unsafe.Pointer(&coords[0]))
+	}
+
+	C.hb_font_set_var_coords_normalized(font, cCoords, C.uint(len(coords)))
+	fontNotifyChange(font)
+}
+
+// FontGetVarCoordsNormalized fetches the list of normalized variation
+// coordinates currently set on the font.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-var-coords-normalized
+func FontGetVarCoordsNormalized(font Font) []int32 {
+	var length C.uint
+	coords := C.hb_font_get_var_coords_normalized(font, &length)
+	if coords == nil || length == 0 {
+		return nil
+	}
+
+	return append([]int32(nil), unsafe.Slice((*int32)(unsafe.Pointer(coords)), length)...)
+}
+
+// FontGetSerial returns the internal serial number of the font. The serial
+// number is increased every time a setting on the font is changed. It can be
+// used to detect whether results cached for the font are still valid.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-get-serial
+func FontGetSerial(font Font) uint32 {
+	return uint32(C.hb_font_get_serial(font))
+}
+
+// FontChanged notifies the font that underlying font data has changed. This has
+// the effect of increasing the serial as returned by FontGetSerial, which
+// invalidates internal caches.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-changed
+func FontChanged(font Font) {
+	C.hb_font_changed(font)
+	fontNotifyChange(font)
+}
+
+// FontChangeFunc is the callback invoked after a setting of the font has been
+// changed through this package.
+type FontChangeFunc func(font Font)
+
+// FontSetChangeFunc sets the callback which is invoked every time the font's
+// face, parent, scale, ppem, ptem, variations or synthetic style is changed
+// using this package's setters, or FontChanged is called. Passing a nil f
+// removes it.
+//
+// The callback is stored as user data on the font, so it's released together
+// with the font. Changes made directly through the C API are not reported.
+func FontSetChangeFunc(font Font, f FontChangeFunc) {
+	if f == nil {
+		C.hb_font_set_user_data(font, &C.hb_go_font_change_func_key, nil, nil, 1)
+		return
+	}
+
+	data := cHandle(f)
+	if C.hb_font_set_user_data(font, &C.hb_go_font_change_func_key, data, cDestroyHandle, 1) == 0 {
+		goDestroyHandle(data)
+	}
+}
+
+// fontNotifyChange invokes the FontChangeFunc of the font, if there's any.
+func fontNotifyChange(font Font) {
+	if data := C.hb_font_get_user_data(font, &C.hb_go_font_change_func_key); data != nil {
+		handleValue(data).(FontChangeFunc)(font)
+	}
+}
+
 // TODO: hb_font_set_funcs
 // TODO: hb_font_set_funcs_data
-// TODO: hb_font_subtract_glyph_origin_for_direction
 // TODO: hb_font_funcs_create
 // TODO: hb_font_funcs_get_empty
 // TODO: hb_font_funcs_reference
@@ -323,11 +630,6 @@ func FontGetScale(font Font) (x, y int32) {
 // TODO: (*hb_font_get_variation_glyph_func_t)
 // TODO: hb_font_funcs_set_variation_glyph_func
 
-// A callback function for FaceCreateForTables.
-//
-// Learn more: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-reference-table-func-t
-type ReferenceTableFunc C.hb_reference_table_func_t
-
 // TODO: (*hb_font_get_font_extents_func_t)
 // TODO: hb_font_funcs_set_font_h_extents_func
 // TODO: hb_font_funcs_set_font_v_extents_func
@@ -17,7 +17,7 @@ func ShapeFull(font Font, buffer Buffer, features []Feature, shaperList []string
 	shapers := cStringArray(shaperList)
 	defer freeStringArray(shapers)
 
-	C.hb_shape_full(font, buffer, (*C.hb_feature_t)(unsafe.Pointer(&features[0])), C.uint(len(features)), &shapers[0])
+	C.hb_shape_full(font, buffer, cFeatures(features), C.uint(len(features)), cStringArrayPtr(shapers))
 }
 
 // ShapeListShapers returns the list of shapers supported by HarfBuzz.
@@ -34,3 +34,23 @@ func ShapeListShapers() (shapers []string) {
 
 	return shapers
 }
+
+// TraceStep is a single step of a shaping trace recorded by ShapeTrace.
+type TraceStep struct {
+	Message string // The message HarfBuzz reported, e.g. "start lookup 3".
+	Buffer  string // The buffer contents serialized when the message was reported.
+}
+
+// ShapeTrace shapes the buffer just like Shape, while recording a snapshot of
+// the buffer, serialized with the given format and flags, at every step
+// HarfBuzz reports. It replaces any message callback already set on the buffer.
+func ShapeTrace(font Font, buffer Buffer, features []Feature, format SerializeFormat, flags SerializeFlags) (steps []TraceStep) {
+	BufferSetMessageFunc(buffer, func(buffer Buffer, font Font, message string) bool {
+		steps = append(steps, TraceStep{Message: message, Buffer: BufferSerialize(buffer, font, format, flags)})
+		return true
+	})
+	defer BufferSetMessageFunc(buffer, nil)
+
+	Shape(font, buffer, features)
+	return steps
+}
@@ -22,7 +22,7 @@ func ShapePlanCreate(face Face, props *SegmentProperties, userFeatures []Feature
 	shapers := cStringArray(shaperList)
 	defer freeStringArray(shapers)
 
-	return C.hb_shape_plan_create(face, (*C.hb_segment_properties_t)(unsafe.Pointer(props)), cFeatures(userFeatures), C.uint(len(userFeatures)), &shapers[0])
+	return C.hb_shape_plan_create(face, (*C.hb_segment_properties_t)(unsafe.Pointer(props)), cFeatures(userFeatures), C.uint(len(userFeatures)), cStringArrayPtr(shapers))
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-shape-plan.html#hb-shape-plan-create-cached
@@ -30,7 +30,7 @@ func ShapePlanCreateCached(face Face, props *SegmentProperties, userFeatures []F
 	shapers := cStringArray(shaperList)
 	defer freeStringArray(shapers)
 
-	return C.hb_shape_plan_create_cached(face, (*C.hb_segment_properties_t)(unsafe.Pointer(props)), cFeatures(userFeatures), C.uint(len(userFeatures)), &shapers[0])
+	return C.hb_shape_plan_create_cached(face, (*C.hb_segment_properties_t)(unsafe.Pointer(props)), cFeatures(userFeatures), C.uint(len(userFeatures)), cStringArrayPtr(shapers))
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-shape-plan.html#hb-shape-plan-create2
@@ -43,7 +43,7 @@ func ShapePlanCreate2(face Face, props *SegmentProperties, userFeatures []Featur
 	shapers := cStringArray(shaperList)
 	defer freeStringArray(shapers)
 
-	return C.hb_shape_plan_create2(face, (*C.hb_segment_properties_t)(unsafe.Pointer(props)), cFeatures(userFeatures), C.uint(len(userFeatures)), cCoords, C.uint(len(coords)), &shapers[0])
+	return C.hb_shape_plan_create2(face, (*C.hb_segment_properties_t)(unsafe.Pointer(props)), cFeatures(userFeatures), C.uint(len(userFeatures)), cCoords, C.uint(len(coords)), cStringArrayPtr(shapers))
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-shape-plan.html#hb-shape-plan-create-cached2
@@ -56,7 +56,7 @@ func ShapePlanCreateCached2(face Face, props *SegmentProperties, userFeatures []
 	shapers := cStringArray(shaperList)
 	defer freeStringArray(shapers)
 
-	return C.hb_shape_plan_create_cached2(face, (*C.hb_segment_properties_t)(unsafe.Pointer(props)), cFeatures(userFeatures), C.uint(len(userFeatures)), cCoords, C.uint(len(coords)), &shapers[0])
+	return C.hb_shape_plan_create_cached2(face, (*C.hb_segment_properties_t)(unsafe.Pointer(props)), cFeatures(userFeatures), C.uint(len(userFeatures)), cCoords, C.uint(len(coords)), cStringArrayPtr(shapers))
 }
 
 // Learn more: https://harfbuzz.github.io/harfbuzz-hb-shape-plan.html#hb-shape-plan-get-empty
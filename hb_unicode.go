@@ -0,0 +1,97 @@
+package hb
+
+// #include <hb.h>
+import "C"
+
+// UnicodeFuncs holds the Unicode character property functions HarfBuzz uses.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-unicode.html#hb-unicode-funcs-t
+type UnicodeFuncs *C.hb_unicode_funcs_t
+
+// UnicodeGeneralCategory is the General_Category (gc) property of a Unicode
+// character.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-unicode.html#hb-unicode-general-category-t
+type UnicodeGeneralCategory C.hb_unicode_general_category_t
+
+const (
+	UnicodeGeneralCategoryControl            UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_CONTROL             // Cc
+	UnicodeGeneralCategoryFormat             UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_FORMAT              // Cf
+	UnicodeGeneralCategoryUnassigned         UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_UNASSIGNED          // Cn
+	UnicodeGeneralCategoryPrivateUse         UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_PRIVATE_USE         // Co
+	UnicodeGeneralCategorySurrogate          UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_SURROGATE           // Cs
+	UnicodeGeneralCategoryLowercaseLetter    UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_LOWERCASE_LETTER    // Ll
+	UnicodeGeneralCategoryModifierLetter     UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_MODIFIER_LETTER     // Lm
+	UnicodeGeneralCategoryOtherLetter        UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_OTHER_LETTER        // Lo
+	UnicodeGeneralCategoryTitlecaseLetter    UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_TITLECASE_LETTER    // Lt
+	UnicodeGeneralCategoryUppercaseLetter    UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_UPPERCASE_LETTER    // Lu
+	UnicodeGeneralCategorySpacingMark        UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_SPACING_MARK        // Mc
+	UnicodeGeneralCategoryEnclosingMark      UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_ENCLOSING_MARK      // Me
+	UnicodeGeneralCategoryNonSpacingMark     UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_NON_SPACING_MARK    // Mn
+	UnicodeGeneralCategoryDecimalNumber      UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_DECIMAL_NUMBER      // Nd
+	UnicodeGeneralCategoryLetterNumber       UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_LETTER_NUMBER       // Nl
+	UnicodeGeneralCategoryOtherNumber        UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_OTHER_NUMBER        // No
+	UnicodeGeneralCategoryConnectPunctuation UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_CONNECT_PUNCTUATION // Pc
+	UnicodeGeneralCategoryDashPunctuation    UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_DASH_PUNCTUATION    // Pd
+	UnicodeGeneralCategoryClosePunctuation   UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_CLOSE_PUNCTUATION   // Pe
+	UnicodeGeneralCategoryFinalPunctuation   UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_FINAL_PUNCTUATION   // Pf
+	UnicodeGeneralCategoryInitialPunctuation UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_INITIAL_PUNCTUATION // Pi
+	UnicodeGeneralCategoryOtherPunctuation   UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_OTHER_PUNCTUATION   // Po
+	UnicodeGeneralCategoryOpenPunctuation    UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_OPEN_PUNCTUATION    // Ps
+	UnicodeGeneralCategoryCurrencySymbol     UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_CURRENCY_SYMBOL     // Sc
+	UnicodeGeneralCategoryModifierSymbol     UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_MODIFIER_SYMBOL     // Sk
+	UnicodeGeneralCategoryMathSymbol         UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_MATH_SYMBOL         // Sm
+	UnicodeGeneralCategoryOtherSymbol        UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_OTHER_SYMBOL        // So
+	UnicodeGeneralCategoryLineSeparator      UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_LINE_SEPARATOR      // Zl
+	UnicodeGeneralCategoryParagraphSeparator UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_PARAGRAPH_SEPARATOR // Zp
+	UnicodeGeneralCategorySpaceSeparator     UnicodeGeneralCategory = C.HB_UNICODE_GENERAL_CATEGORY_SPACE_SEPARATOR     // Zs
+)
+
+// UnicodeFuncsGetDefault fetches a pointer to the default Unicode-functions
+// structure that is used when no functions are explicitly set on a Buffer.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-unicode.html#hb-unicode-funcs-get-default
+func UnicodeFuncsGetDefault() UnicodeFuncs {
+	return C.hb_unicode_funcs_get_default()
+}
+
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-unicode.html#hb-unicode-funcs-reference
+func UnicodeFuncsReference(ufuncs UnicodeFuncs) UnicodeFuncs {
+	return C.hb_unicode_funcs_reference(ufuncs)
+}
+
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-unicode.html#hb-unicode-funcs-destroy
+func UnicodeFuncsDestroy(ufuncs UnicodeFuncs) {
+	C.hb_unicode_funcs_destroy(ufuncs)
+}
+
+// UnicodeCombiningClass retrieves the Canonical Combining Class (ccc) property
+// of code point unicode.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-unicode.html#hb-unicode-combining-class
+func UnicodeCombiningClass(ufuncs UnicodeFuncs, unicode Codepoint) uint32 {
+	return uint32(C.hb_unicode_combining_class(ufuncs, C.hb_codepoint_t(unicode)))
+}
+
+// UnicodeGeneralCategoryOf retrieves the General Category (gc) property of code
+// point unicode.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-unicode.html#hb-unicode-general-category
+func UnicodeGeneralCategoryOf(ufuncs UnicodeFuncs, unicode Codepoint) UnicodeGeneralCategory {
+	return UnicodeGeneralCategory(C.hb_unicode_general_category(ufuncs, C.hb_codepoint_t(unicode)))
+}
+
+// UnicodeMirroring retrieves the Bi-directional Mirroring Glyph code point
+// defined for code point unicode, or unicode itself if there's none.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-unicode.html#hb-unicode-mirroring
+func UnicodeMirroring(ufuncs UnicodeFuncs, unicode Codepoint) Codepoint {
+	return Codepoint(C.hb_unicode_mirroring(ufuncs, C.hb_codepoint_t(unicode)))
+}
+
+// UnicodeScript retrieves the Script property of code point unicode.
+//
+// Learn more: https://harfbuzz.github.io/harfbuzz-hb-unicode.html#hb-unicode-script
+func UnicodeScript(ufuncs UnicodeFuncs, unicode Codepoint) Script {
+	return Script(C.hb_unicode_script(ufuncs, C.hb_codepoint_t(unicode)))
+}
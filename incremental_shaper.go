@@ -0,0 +1,313 @@
+package hb
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrEditOutOfRange is returned when an edit's range is not within the text.
+var ErrEditOutOfRange = errors.New("hb: edit range out of bounds")
+
+// IncrementalShaper keeps a text along with its shaped buffer, and reshapes
+// only the part of the text around an edit, as text editors need to. It's not
+// safe for concurrent use.
+type IncrementalShaper struct {
+	font Font
+	opts ShapeOptions
+
+	text     string
+	buffer   Buffer
+	props    SegmentProperties
+	reshaped TextRange
+}
+
+// NewIncrementalShaper creates an IncrementalShaper for the UTF-8 encoded text,
+// and shapes it with font and opts. opts.Scale only applies to Run.
+//
+// The IncrementalShaper holds a reference to font until it's destroyed by
+// Destroy.
+func NewIncrementalShaper(font Font, text string, opts ShapeOptions) (*IncrementalShaper, error) {
+	if font == nil {
+		return nil, ErrNilFont
+	}
+
+	opts.Features = append([]Feature(nil), opts.Features...)
+	opts.Flags |= BufferFlagProduceUnsafeToConcat
+
+	s := &IncrementalShaper{font: FontReference(font), opts: opts}
+	if err := s.SetText(text); err != nil {
+		s.Destroy()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Text returns the current text.
+func (s *IncrementalShaper) Text() string {
+	return s.text
+}
+
+// Buffer returns the shaped buffer of the current text, whose clusters are
+// byte offsets in the text. It's valid until the next call to SetText, Edit or
+// Destroy, and must not be modified.
+func (s *IncrementalShaper) Buffer() Buffer {
+	return s.buffer
+}
+
+// Run returns the ShapedRun of the current text.
+func (s *IncrementalShaper) Run() *ShapedRun {
+	return newShapedRun(s.font, s.buffer, len(s.text), s.opts.Scale)
+}
+
+// Reshaped returns the range of the current text which was shaped by the last
+// call to SetText or Edit. The glyphs outside of it were kept as they were.
+func (s *IncrementalShaper) Reshaped() TextRange {
+	return s.reshaped
+}
+
+// SetText replaces the whole text, and shapes it from scratch.
+func (s *IncrementalShaper) SetText(text string) error {
+	buffer := BufferCreate()
+	bufferSetupText(buffer, text, &s.opts)
+
+	return s.shapeFull(buffer, text)
+}
+
+// Edit replaces the bytes of the text in [start, end) with replacement, and
+// reshapes the smallest part of the new text it can.
+//
+// The part is bounded by clusters of the previous buffer which aren't
+// GlyphFlagUnsafeToConcat, and is widened until the newly shaped glyphs at its
+// edges aren't unsafe to concat either, so that splicing them in gives the
+// same result as shaping the whole text. The whole text is reshaped if its
+// guessed segment properties change, or with ClusterLevelCharacters.
+func (s *IncrementalShaper) Edit(start, end int, replacement string) error {
+	if start < 0 || start > end || end > len(s.text) {
+		return ErrEditOutOfRange
+	}
+
+	text := s.text[:start] + replacement + s.text[end:]
+	delta := len(replacement) - (end - start)
+
+	// Set up the whole text, to find out whether its properties changed, and
+	// to be shaped right away if they did.
+	full := BufferCreate()
+	bufferSetupText(full, text, &s.opts)
+
+	props := BufferGetSegmentProperties(full)
+	if s.opts.ClusterLevel == ClusterLevelCharacters || !SegmentPropertiesEqual(&props, &s.props) {
+		return s.shapeFull(full, text)
+	}
+
+	lefts, rights := s.boundaries(start, end)
+	for i, j := 0, 0; ; {
+		left, right := lefts[i], rights[j]
+		if left == 0 && right == len(s.text) {
+			return s.shapeFull(full, text)
+		}
+
+		piece, ok, err := s.shapePiece(text, left, right+delta)
+		if err != nil {
+			BufferDestroy(full)
+			return err
+		}
+
+		if ok {
+			BufferDestroy(full)
+			s.splice(piece, text, left, right, delta)
+			BufferDestroy(piece)
+			return nil
+		}
+		BufferDestroy(piece)
+
+		if i+1 < len(lefts) {
+			i++
+		}
+		if j+1 < len(rights) {
+			j++
+		}
+	}
+}
+
+// Verify shapes the whole current text from scratch, and compares the result
+// with the incrementally shaped buffer using BufferDiff. It returns
+// BufferDiffFlagEqual if they match, ignoring glyph flags, which may differ
+// around the reshaped part.
+//
+// It's as expensive as shaping the whole text, and is meant for tests.
+func (s *IncrementalShaper) Verify() BufferDiffFlags {
+	reference := BufferCreate()
+	defer BufferDestroy(reference)
+
+	bufferSetupText(reference, s.text, &s.opts)
+	Shape(s.font, reference, s.opts.Features)
+
+	dottedCircle, _ := FontGetNominalGlyph(s.font, 0x25CC)
+	diff := BufferDiff(s.buffer, reference, dottedCircle, 0)
+
+	return diff &^ (BufferDiffFlagGlyphFlagsMismatch | BufferDiffFlagNotdefPresent | BufferDiffFlagDottedCirclePresent)
+}
+
+// Destroy releases the buffer and the font reference of the IncrementalShaper.
+// It must not be used afterwards.
+func (s *IncrementalShaper) Destroy() {
+	if s.buffer != nil {
+		BufferDestroy(s.buffer)
+	}
+	FontDestroy(s.font)
+}
+
+// shapeFull shapes the whole text, already set up in buffer, and takes the
+// ownership of buffer.
+func (s *IncrementalShaper) shapeFull(buffer Buffer, text string) error {
+	Shape(s.font, buffer, s.opts.Features)
+	if !BufferAllocationSuccessful(buffer) {
+		BufferDestroy(buffer)
+		return ErrAllocationFailed
+	}
+
+	if s.buffer != nil {
+		BufferDestroy(s.buffer)
+	}
+	s.buffer = buffer
+	s.text = text
+	s.props = BufferGetSegmentProperties(buffer)
+	s.reshaped = TextRange{Start: 0, End: len(text)}
+
+	return nil
+}
+
+// boundaries returns the clusters of the current buffer it's safe to reshape
+// from, at or before start, nearest first, ending with 0, and the clusters
+// it's safe to reshape up to, at or after end, nearest first, ending with the
+// length of the text.
+func (s *IncrementalShaper) boundaries(start, end int) (lefts, rights []int) {
+	unsafeClusters := make(map[int]bool)
+	for _, info := range BufferGetGlyphInfosView(s.buffer) {
+		cluster := int(info.Cluster)
+		unsafeClusters[cluster] = unsafeClusters[cluster] || GlyphFlags(info.mask)&GlyphFlagUnsafeToConcat != 0
+	}
+
+	for cluster, unsafe := range unsafeClusters {
+		if unsafe || cluster == 0 {
+			continue
+		}
+		if cluster <= start {
+			lefts = append(lefts, cluster)
+		}
+		if cluster >= end {
+			rights = append(rights, cluster)
+		}
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(lefts)))
+	sort.Ints(rights)
+
+	return append(lefts, 0), append(rights, len(s.text))
+}
+
+// shapePiece shapes text[start:end], with the rest of the text as context, and
+// reports whether its edges are safe to splice.
+func (s *IncrementalShaper) shapePiece(text string, start, end int) (piece Buffer, ok bool, err error) {
+	piece = BufferCreate()
+	BufferAddUTF8Item(piece, text, start, end-start)
+	BufferSetSegmentProperties(piece, s.props)
+	BufferSetClusterLevel(piece, s.opts.ClusterLevel)
+	BufferSetNotFoundGlyph(piece, s.opts.NotFoundGlyph)
+
+	flags := s.opts.Flags
+	if start > 0 {
+		flags &^= BufferFlagBot
+	}
+	if end < len(text) {
+		flags &^= BufferFlagEot
+	}
+	BufferSetFlags(piece, flags)
+
+	Shape(s.font, piece, s.opts.Features)
+	if !BufferAllocationSuccessful(piece) {
+		BufferDestroy(piece)
+		return nil, false, ErrAllocationFailed
+	}
+
+	infos := BufferGetGlyphInfosView(piece)
+
+	last := start
+	for _, info := range infos {
+		if int(info.Cluster) > last {
+			last = int(info.Cluster)
+		}
+	}
+
+	// Checking the last cluster is conservative, as the flag is about breaking
+	// before a glyph, but lookups running out of the piece mark it.
+	for _, info := range infos {
+		if GlyphFlags(info.mask)&GlyphFlagUnsafeToConcat == 0 {
+			continue
+		}
+		if (start > 0 && int(info.Cluster) == start) || (end < len(text) && int(info.Cluster) == last) {
+			return piece, false, nil
+		}
+	}
+
+	return piece, true, nil
+}
+
+// splice replaces the glyphs of the clusters in [left, right) of the current
+// buffer with piece, whose clusters are already offsets in text, and shifts
+// the clusters after it by delta.
+func (s *IncrementalShaper) splice(piece Buffer, text string, left, right, delta int) {
+	infos := BufferGetGlyphInfosView(s.buffer)
+
+	// The glyphs before and after the piece, in logical order, are each a
+	// contiguous range of the buffer, as clusters are monotone.
+	before := TextRange{Start: len(infos)}
+	after := TextRange{Start: len(infos)}
+	for i, info := range infos {
+		switch cluster := int(info.Cluster); {
+		case cluster < left:
+			if i < before.Start {
+				before.Start = i
+			}
+			before.End = i + 1
+		case cluster >= right:
+			if i < after.Start {
+				after.Start = i
+			}
+			after.End = i + 1
+		}
+	}
+
+	merged := BufferCreateSimilar(s.buffer)
+	appendGlyphs := func(r TextRange, shift int) {
+		if r.Start >= r.End {
+			return
+		}
+
+		offset := int(BufferGetLength(merged))
+		BufferAppend(merged, s.buffer, uint32(r.Start), uint32(r.End))
+
+		if shift != 0 {
+			mergedInfos := BufferGetGlyphInfosView(merged)
+			for i := offset; i < len(mergedInfos); i++ {
+				mergedInfos[i].Cluster = uint32(int(mergedInfos[i].Cluster) + shift)
+			}
+		}
+	}
+
+	if DirectionIsBackward(s.props.Direction) {
+		appendGlyphs(after, delta)
+		BufferAppend(merged, piece, 0, BufferGetLength(piece))
+		appendGlyphs(before, 0)
+	} else {
+		appendGlyphs(before, 0)
+		BufferAppend(merged, piece, 0, BufferGetLength(piece))
+		appendGlyphs(after, delta)
+	}
+
+	BufferDestroy(s.buffer)
+	s.buffer = merged
+	s.text = text
+	s.reshaped = TextRange{Start: left, End: right + delta}
+}
@@ -0,0 +1,120 @@
+package hb
+
+import "testing"
+
+type incrementalEdit struct {
+	start, end  int
+	replacement string
+}
+
+var incrementalShaperTests = []struct {
+	name  string
+	font  string // The environment variable naming the font, see testFont.
+	text  string
+	edits []incrementalEdit
+}{
+	{
+		name: "ltr",
+		font: "HB_TEST_FONT",
+		text: "Hello office, AVAVA fit",
+		edits: []incrementalEdit{
+			{0, 0, "Oh, "},    // insert at the start
+			{27, 27, " flow"}, // insert at the end
+			{11, 14, "fi"},    // delete inside a ligature
+			{10, 13, "offi"},  // insert into a ligature
+			{19, 22, "AWA"},   // replace across kerning pairs
+			{2, 4, ""},        // delete a word edge
+			{0, 2, "Ah"},      // replace at the start
+			{25, 30, ""},      // delete at the end
+		},
+	},
+	{
+		name: "rtl",
+		font: "HB_TEST_FONT",
+		text: "שלום עולם", // Hebrew, 2 bytes per letter.
+		edits: []incrementalEdit{
+			{0, 0, "אב "},  // insert at the start
+			{22, 22, " ג"}, // insert at the end
+			{5, 7, "ד"},    // replace the first letter of a word
+			{13, 16, "ע"},  // delete a word edge
+			{0, 4, ""},     // delete at the start
+			{15, 20, "ם"},  // delete at the end
+		},
+	},
+	{
+		name: "arabic",
+		font: "HB_TEST_ARABIC_FONT",
+		text: "سلام دنیا", // Arabic, 2 bytes per letter.
+		edits: []incrementalEdit{
+			{8, 9, ""},     // delete the space, joining the words
+			{6, 10, "مبد"}, // insert a dual-joining letter in between
+			{8, 10, " "},   // replace it with a space
+			{0, 0, "ب"},    // insert before the initial form
+			{15, 19, "یه"}, // replace the final form
+			{19, 19, "ها"}, // insert after the final form
+			{4, 8, "ل"},    // delete a medial form
+			{0, 4, "لاس"},  // replace the start with a lam-alef ligature
+		},
+	},
+}
+
+func TestIncrementalShaper(t *testing.T) {
+	for _, test := range incrementalShaperTests {
+		t.Run(test.name, func(t *testing.T) {
+			font := testFont(t, test.font)
+
+			s, err := NewIncrementalShaper(font, test.text, ShapeOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer s.Destroy()
+
+			want := test.text
+			for _, edit := range test.edits {
+				if err := s.Edit(edit.start, edit.end, edit.replacement); err != nil {
+					t.Fatalf("Edit(%d, %d, %q) on %q: %v", edit.start, edit.end, edit.replacement, want, err)
+				}
+
+				want = want[:edit.start] + edit.replacement + want[edit.end:]
+				if got := s.Text(); got != want {
+					t.Fatalf("Edit(%d, %d, %q): got text %q, want %q", edit.start, edit.end, edit.replacement, got, want)
+				}
+
+				if diff := diffFullShape(s); diff != BufferDiffFlagEqual {
+					t.Errorf("Edit(%d, %d, %q) to %q: got BufferDiff %#x, want BufferDiffFlagEqual", edit.start, edit.end, edit.replacement, want, diff)
+				}
+			}
+		})
+	}
+}
+
+// diffFullShape compares the buffer of s with the whole text shaped from
+// scratch. Glyph flags are ignored, as they may differ around the reshaped part,
+// along with the flags reporting .notdef and dotted circle glyphs, which aren't
+// mismatches.
+func diffFullShape(s *IncrementalShaper) BufferDiffFlags {
+	reference := BufferCreate()
+	defer BufferDestroy(reference)
+
+	bufferSetupText(reference, s.Text(), &s.opts)
+	Shape(s.font, reference, s.opts.Features)
+
+	dottedCircle, _ := FontGetNominalGlyph(s.font, 0x25CC)
+	diff := BufferDiff(s.Buffer(), reference, dottedCircle, 0)
+
+	return diff &^ (BufferDiffFlagGlyphFlagsMismatch | BufferDiffFlagNotdefPresent | BufferDiffFlagDottedCirclePresent)
+}
+
+func TestIncrementalShaperEditOutOfRange(t *testing.T) {
+	s, err := NewIncrementalShaper(testFont(t, "HB_TEST_FONT"), "Hello", ShapeOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Destroy()
+
+	for _, edit := range []incrementalEdit{{-1, 0, ""}, {3, 2, ""}, {0, 6, ""}} {
+		if err := s.Edit(edit.start, edit.end, edit.replacement); err != ErrEditOutOfRange {
+			t.Errorf("Edit(%d, %d, %q): got %v, want ErrEditOutOfRange", edit.start, edit.end, edit.replacement, err)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package hb
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxPairedBrackets is the depth of nested brackets ItemizeScripts tracks.
+const maxPairedBrackets = 64
+
+// ScriptRun is a run of text in a single script, as split by ItemizeScripts.
+type ScriptRun struct {
+	Start int // The byte offset of the run in the text.
+	End   int // The byte offset of the end of the run in the text.
+
+	// Properties holds the run's script, its horizontal direction and the
+	// language, to be set on a buffer using BufferSetSegmentProperties. The
+	// direction is only a default, see ItemizeScripts.
+	Properties SegmentProperties
+}
+
+// ItemizeScripts splits the UTF-8 encoded text into runs of a single Script,
+// so that each one is shaped with the right script, unlike
+// BufferGuessSegmentProperties which uses the first script found for the
+// whole buffer.
+//
+// Characters of the Common and Inherited scripts, such as spaces, punctuation
+// and combining marks, take the script of the preceding character, or of the
+// following one at the start of the text. Paired brackets take the script of
+// the text they're opened in, so that both brackets of a pair match.
+//
+// The direction of a run is the horizontal direction of its script, or
+// DirectionLTR if it has none, which only suits text of a single direction:
+// digits and neutrals resolved to the script of an Arabic run come out RTL, for
+// example. For mixed text, the direction must come from the bidi levels instead,
+// by splitting the runs at the boundaries of the runs of a NewBidiParagraph and
+// taking their direction. The runs are in logical order, and aren't reordered
+// for display. language is set on all of the runs, and nil means the default
+// language.
+func ItemizeScripts(text string, language Language) []ScriptRun {
+	if language == nil {
+		language = LanguageGetDefault()
+	}
+
+	ufuncs := UnicodeFuncsGetDefault()
+	scripts := make([]Script, 0, len(text))
+
+	type bracket struct {
+		pair   Codepoint
+		script Script
+	}
+	var brackets []bracket
+
+	current := ScriptInvalid
+	for _, r := range text {
+		script := ScriptCommon
+		switch {
+		case r >= utf8.RuneSelf:
+			script = UnicodeScript(ufuncs, Codepoint(r))
+		case 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z':
+			// The letters are the only ASCII characters with a script.
+			script = ScriptLatin
+		}
+
+		switch script {
+		case ScriptInherited:
+			script = current
+
+		case ScriptCommon:
+			script = current
+
+			switch {
+			case unicode.Is(unicode.Ps, r):
+				if len(brackets) == maxPairedBrackets {
+					brackets = brackets[1:]
+				}
+				brackets = append(brackets, bracket{pair: UnicodeMirroring(ufuncs, Codepoint(r)), script: current})
+
+			case unicode.Is(unicode.Pe, r):
+				for i := len(brackets) - 1; i >= 0; i-- {
+					if brackets[i].pair == Codepoint(r) {
+						script, current = brackets[i].script, brackets[i].script
+						brackets = brackets[:i]
+						break
+					}
+				}
+			}
+
+		default:
+			current = script
+
+			// Brackets opened before any script was found take the first one.
+			for i := range brackets {
+				if brackets[i].script == ScriptInvalid {
+					brackets[i].script = script
+				}
+			}
+		}
+
+		scripts = append(scripts, script)
+	}
+
+	// The leading characters take the first script found, which can only be
+	// after them.
+	first := ScriptCommon
+	for _, script := range scripts {
+		if script != ScriptInvalid {
+			first = script
+			break
+		}
+	}
+	for i := range scripts {
+		if scripts[i] == ScriptInvalid {
+			scripts[i] = first
+		}
+	}
+
+	var runs []ScriptRun
+
+	for i, offset := 0, 0; offset < len(text); i++ {
+		_, size := utf8.DecodeRuneInString(text[offset:])
+		script := scripts[i]
+		start := offset
+		offset += size
+
+		if n := len(runs); n > 0 && runs[n-1].Properties.Script == script {
+			runs[n-1].End = offset
+			continue
+		}
+
+		direction := ScriptGetHorizontalDirection(script)
+		if direction == DirectionInvalid {
+			direction = DirectionLTR
+		}
+
+		runs = append(runs, ScriptRun{
+			Start: start,
+			End:   offset,
+			Properties: SegmentProperties{
+				Direction: direction,
+				Script:    script,
+				Language:  language,
+			},
+		})
+	}
+
+	return runs
+}
@@ -0,0 +1,85 @@
+package hb
+
+import "testing"
+
+func TestItemizeScripts(t *testing.T) {
+	type wantRun struct {
+		start, end int
+		script     Script
+		direction  Direction
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want []wantRun
+	}{
+		{
+			name: "common",
+			text: "abc אבג",
+			want: []wantRun{{0, 4, ScriptLatin, DirectionLTR}, {4, 10, ScriptHebrew, DirectionRTL}},
+		},
+		{
+			// The digits take the script of the following text, and its
+			// direction too, which bidi has to fix.
+			name: "leading common",
+			text: "12 אב",
+			want: []wantRun{{0, 7, ScriptHebrew, DirectionRTL}},
+		},
+		{
+			name: "inherited",
+			text: "a\u0301א\u0301",
+			want: []wantRun{{0, 3, ScriptLatin, DirectionLTR}, {3, 7, ScriptHebrew, DirectionRTL}},
+		},
+		{
+			name: "only common",
+			text: "1, 2",
+			want: []wantRun{{0, 4, ScriptCommon, DirectionLTR}},
+		},
+		{
+			// The closing bracket takes the script of the opening one, rather
+			// than of the Latin text it follows.
+			name: "brackets",
+			text: "א (abc) ב",
+			want: []wantRun{{0, 4, ScriptHebrew, DirectionRTL}, {4, 7, ScriptLatin, DirectionLTR}, {7, 11, ScriptHebrew, DirectionRTL}},
+		},
+		{
+			name: "nested brackets",
+			text: "א [(ab) c] ב",
+			want: []wantRun{{0, 5, ScriptHebrew, DirectionRTL}, {5, 7, ScriptLatin, DirectionLTR}, {7, 9, ScriptHebrew, DirectionRTL}, {9, 10, ScriptLatin, DirectionLTR}, {10, 14, ScriptHebrew, DirectionRTL}},
+		},
+		{
+			// The brackets opened before any script take the first one.
+			name: "leading brackets",
+			text: "(ab) א",
+			want: []wantRun{{0, 5, ScriptLatin, DirectionLTR}, {5, 7, ScriptHebrew, DirectionRTL}},
+		},
+		{
+			// A closing bracket without an opening one is like any other
+			// Common character.
+			name: "unpaired bracket",
+			text: "א] b)",
+			want: []wantRun{{0, 4, ScriptHebrew, DirectionRTL}, {4, 6, ScriptLatin, DirectionLTR}},
+		},
+	}
+
+	language := LanguageFromString("en")
+	for _, tt := range tests {
+		runs := ItemizeScripts(tt.text, language)
+
+		if len(runs) != len(tt.want) {
+			t.Errorf("%s: got %d runs %v, want %d", tt.name, len(runs), runs, len(tt.want))
+			continue
+		}
+		for i, run := range runs {
+			w := tt.want[i]
+			if run.Start != w.start || run.End != w.end || run.Properties.Script != w.script || run.Properties.Direction != w.direction {
+				t.Errorf("%s: got run %d [%d:%d] with script %v and direction %v, want [%d:%d] with %v and %v",
+					tt.name, i, run.Start, run.End, run.Properties.Script, run.Properties.Direction, w.start, w.end, w.script, w.direction)
+			}
+			if run.Properties.Language != language {
+				t.Errorf("%s: got run %d with language %v, want %v", tt.name, i, run.Properties.Language, language)
+			}
+		}
+	}
+}
@@ -0,0 +1,386 @@
+package hb
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// LineBreakClass is the Line_Break property of a Unicode character, which
+// drives the Unicode Line Breaking Algorithm (UAX #14).
+type LineBreakClass uint8
+
+const (
+	LineBreakXX  LineBreakClass = iota // Unknown.
+	LineBreakBK                        // Mandatory break.
+	LineBreakCR                        // Carriage return.
+	LineBreakLF                        // Line feed.
+	LineBreakCM                        // Combining mark.
+	LineBreakNL                        // Next line.
+	LineBreakSG                        // Surrogate.
+	LineBreakWJ                        // Word joiner.
+	LineBreakZW                        // Zero width space.
+	LineBreakGL                        // Non-breaking ("glue").
+	LineBreakSP                        // Space.
+	LineBreakZWJ                       // Zero width joiner.
+	LineBreakB2                        // Break opportunity before and after.
+	LineBreakBA                        // Break after.
+	LineBreakBB                        // Break before.
+	LineBreakHY                        // Hyphen.
+	LineBreakCB                        // Contingent break opportunity.
+	LineBreakCL                        // Close punctuation.
+	LineBreakCP                        // Close parenthesis.
+	LineBreakEX                        // Exclamation or interrogation.
+	LineBreakIN                        // Inseparable.
+	LineBreakNS                        // Nonstarter.
+	LineBreakOP                        // Open punctuation.
+	LineBreakQU                        // Quotation.
+	LineBreakIS                        // Infix numeric separator.
+	LineBreakNU                        // Numeric.
+	LineBreakPO                        // Postfix numeric.
+	LineBreakPR                        // Prefix numeric.
+	LineBreakSY                        // Symbols allowing break after.
+	LineBreakAI                        // Ambiguous (alphabetic or ideographic).
+	LineBreakAL                        // Alphabetic.
+	LineBreakCJ                        // Conditional Japanese starter.
+	LineBreakEB                        // Emoji base.
+	LineBreakEM                        // Emoji modifier.
+	LineBreakH2                        // Hangul LV syllable.
+	LineBreakH3                        // Hangul LVT syllable.
+	LineBreakHL                        // Hebrew letter.
+	LineBreakID                        // Ideographic.
+	LineBreakJL                        // Hangul L jamo.
+	LineBreakJV                        // Hangul V jamo.
+	LineBreakJT                        // Hangul T jamo.
+	LineBreakRI                        // Regional indicator.
+	LineBreakSA                        // Complex context dependent (South East Asian).
+)
+
+// lineBreakClassRange is a range of code points of a single LineBreakClass.
+type lineBreakClassRange struct {
+	lo, hi rune
+	class  LineBreakClass
+}
+
+// LineBreakClassOf returns the LineBreakClass of r.
+func LineBreakClassOf(r rune) LineBreakClass {
+	i := sort.Search(len(lineBreakClasses), func(i int) bool { return lineBreakClasses[i].hi >= r })
+	if i < len(lineBreakClasses) && lineBreakClasses[i].lo <= r {
+		return lineBreakClasses[i].class
+	}
+
+	return LineBreakXX
+}
+
+// LineBreak is a line break opportunity found by FindLineBreaks.
+type LineBreak struct {
+	Offset    int  // The byte offset in the text the line may break before.
+	Mandatory bool // Whether the line must break there, as after a newline.
+}
+
+// lineBreakState is the state of FindLineBreaks, as of the last character.
+type lineBreakState struct {
+	prev         LineBreakClass // The class of the last character, after LB9 and LB10.
+	prevRune     rune           // The last character, not counting the ones absorbed by LB9.
+	beforePrev   LineBreakClass // The class of the character before prev.
+	beforeSpaces LineBreakClass // The class of the last character which isn't a space.
+	zwj          bool           // Whether the last character is a zero width joiner.
+	regional     int            // The number of regional indicators ending at prev.
+
+	number       bool // Whether the text ends with NU (NU|SY|IS)*, as of LB25.
+	closedNumber bool // Whether the text ends with NU (NU|SY|IS)* (CL|CP).
+}
+
+// FindLineBreaks returns the line break opportunities of the UTF-8 encoded
+// text, found with the default rules of the Unicode Line Breaking Algorithm
+// (UAX #14), in order. The end of a non-empty text is always a mandatory
+// break.
+//
+// Complex context dependent characters (LineBreakSA), such as Thai, are
+// treated as alphabetic, so that no break is found between them without a
+// dictionary. Open and close punctuation is considered East Asian wide in the
+// CJK blocks, from U+3000 to U+FFEF, as well as U+2329.
+func FindLineBreaks(text string) []LineBreak {
+	var breaks []LineBreak
+	var state lineBreakState
+
+	ufuncs := UnicodeFuncsGetDefault()
+
+	r, size := utf8.DecodeRuneInString(text)
+	class := lineBreakResolveClass(ufuncs, r)
+
+	for offset := 0; offset < len(text); {
+		// LB25 looks one character ahead.
+		following, followingSize := utf8.DecodeRuneInString(text[offset+size:])
+		next := LineBreakXX
+		if followingSize > 0 {
+			next = lineBreakResolveClass(ufuncs, following)
+		}
+
+		// LB2: never break at the start of the text.
+		absorbed := false
+		if offset > 0 {
+			var action lineBreakAction
+			action, absorbed = state.decide(ufuncs, class, next, r)
+			if action != lineBreakProhibited {
+				breaks = append(breaks, LineBreak{Offset: offset, Mandatory: action == lineBreakMandatory})
+			}
+		}
+
+		switch {
+		case absorbed:
+		case class == LineBreakCM || class == LineBreakZWJ:
+			// LB10: treat the marks with no base as alphabetic.
+			state.advance(LineBreakAL, r)
+		default:
+			state.advance(class, r)
+		}
+		state.zwj = class == LineBreakZWJ
+
+		offset += size
+		r, size, class = following, followingSize, next
+	}
+
+	// LB3: always break at the end of the text.
+	if len(text) > 0 {
+		breaks = append(breaks, LineBreak{Offset: len(text), Mandatory: true})
+	}
+
+	return breaks
+}
+
+// lineBreakAction is whether a line may, may not, or must break between two
+// characters.
+type lineBreakAction uint8
+
+const (
+	lineBreakProhibited lineBreakAction = iota
+	lineBreakAllowed
+	lineBreakMandatory
+)
+
+// lineBreakResolveClass returns the class of r, with the ambiguous and unknown
+// classes resolved following LB1.
+func lineBreakResolveClass(ufuncs UnicodeFuncs, r rune) LineBreakClass {
+	switch class := LineBreakClassOf(r); class {
+	case LineBreakAI, LineBreakSG, LineBreakXX:
+		return LineBreakAL
+	case LineBreakCJ:
+		return LineBreakNS
+	case LineBreakSA:
+		switch UnicodeGeneralCategoryOf(ufuncs, Codepoint(r)) {
+		case UnicodeGeneralCategoryNonSpacingMark, UnicodeGeneralCategorySpacingMark:
+			return LineBreakCM
+		}
+		return LineBreakAL
+	default:
+		return class
+	}
+}
+
+// advance moves the state past a character of class, after LB9 and LB10.
+func (s *lineBreakState) advance(class LineBreakClass, r rune) {
+	s.beforePrev = s.prev
+	if class != LineBreakSP {
+		s.beforeSpaces = class
+	}
+	if class == LineBreakRI {
+		s.regional++
+	} else {
+		s.regional = 0
+	}
+
+	switch {
+	case class == LineBreakNU:
+		s.number, s.closedNumber = true, false
+	case s.number && (class == LineBreakSY || class == LineBreakIS):
+	case s.number && (class == LineBreakCL || class == LineBreakCP):
+		s.number, s.closedNumber = false, true
+	default:
+		s.number, s.closedNumber = false, false
+	}
+
+	s.prev = class
+	s.prevRune = r
+}
+
+// decide applies the rules LB4 to LB31 to the break between the last
+// character and r, of class, followed by a character of class next. absorbed reports whether r is a combining mark
+// which takes the class of the character before it, following LB9.
+func (s *lineBreakState) decide(ufuncs UnicodeFuncs, class, next LineBreakClass, r rune) (action lineBreakAction, absorbed bool) {
+	prev := s.prev
+
+	// LB4, LB5: break after hard line breaks, but not within CR LF.
+	switch prev {
+	case LineBreakBK, LineBreakLF, LineBreakNL:
+		return lineBreakMandatory, false
+	case LineBreakCR:
+		if class == LineBreakLF {
+			return lineBreakProhibited, false
+		}
+		return lineBreakMandatory, false
+	}
+
+	// LB6, LB7: don't break before hard line breaks, spaces or zero width
+	// spaces.
+	switch class {
+	case LineBreakBK, LineBreakCR, LineBreakLF, LineBreakNL, LineBreakSP, LineBreakZW:
+		return lineBreakProhibited, false
+	}
+
+	// LB8: break after zero width spaces, even when followed by spaces.
+	if s.beforeSpaces == LineBreakZW {
+		return lineBreakAllowed, false
+	}
+
+	// LB8a: don't break after zero width joiners, which are never preceded
+	// by spaces in the state, as LB10 makes them alphabetic.
+	if s.zwj {
+		return lineBreakProhibited, class == LineBreakCM || class == LineBreakZWJ
+	}
+
+	// LB9: don't break before combining marks, which take the class of their
+	// base. LB10: treat the remaining ones as alphabetic.
+	if class == LineBreakCM || class == LineBreakZWJ {
+		if prev != LineBreakSP {
+			return lineBreakProhibited, true
+		}
+		class = LineBreakAL
+	}
+
+	if lineBreakProhibits(s, prev, class, next, r) {
+		return lineBreakProhibited, false
+	}
+
+	// LB30b: don't break between emoji bases and emoji modifiers, counting
+	// the reserved code points of the emoji blocks as emoji bases.
+	if class == LineBreakEM && (prev == LineBreakEB || prev == LineBreakID && s.prevRune >= 0x1F000 && UnicodeGeneralCategoryOf(ufuncs, Codepoint(s.prevRune)) == UnicodeGeneralCategoryUnassigned) {
+		return lineBreakProhibited, false
+	}
+
+	// LB31: break everywhere else.
+	return lineBreakAllowed, false
+}
+
+// lineBreakProhibits reports whether the rules LB11 to LB30a prohibit a break
+// between prev and r, of class, unless LB18 or LB20 allow it first.
+func lineBreakProhibits(s *lineBreakState, prev, class, next LineBreakClass, r rune) bool {
+	isAlphabetic := func(class LineBreakClass) bool {
+		return class == LineBreakAL || class == LineBreakHL
+	}
+	isHangul := func(class LineBreakClass) bool {
+		switch class {
+		case LineBreakJL, LineBreakJV, LineBreakJT, LineBreakH2, LineBreakH3:
+			return true
+		}
+		return false
+	}
+
+	switch {
+	// LB11: don't break around word joiners.
+	case class == LineBreakWJ || prev == LineBreakWJ:
+		return true
+
+	// LB12, LB12a: don't break after non-breaking characters, nor before them
+	// except after spaces and hyphens.
+	case prev == LineBreakGL:
+		return true
+	case class == LineBreakGL && prev != LineBreakSP && prev != LineBreakBA && prev != LineBreakHY:
+		return true
+
+	// LB13: don't break before closing punctuation, even after spaces.
+	case class == LineBreakCL || class == LineBreakCP || class == LineBreakEX || class == LineBreakIS || class == LineBreakSY:
+		return true
+
+	// LB14 to LB17: don't break after opening punctuation, nor within some
+	// pairs, even with spaces in between.
+	case s.beforeSpaces == LineBreakOP:
+		return true
+	case s.beforeSpaces == LineBreakQU && class == LineBreakOP:
+		return true
+	case (s.beforeSpaces == LineBreakCL || s.beforeSpaces == LineBreakCP) && class == LineBreakNS:
+		return true
+	case s.beforeSpaces == LineBreakB2 && class == LineBreakB2:
+		return true
+
+	// LB18: break after spaces.
+	case prev == LineBreakSP:
+		return false
+
+	// LB19: don't break around quotation marks.
+	case class == LineBreakQU || prev == LineBreakQU:
+		return true
+
+	// LB20: break around contingent break opportunities.
+	case class == LineBreakCB || prev == LineBreakCB:
+		return false
+
+	// LB21 to LB21b: don't break before hyphens, small kana and other
+	// nonstarters, nor after the characters which break before.
+	case class == LineBreakBA || class == LineBreakHY || class == LineBreakNS || prev == LineBreakBB:
+		return true
+	case s.beforePrev == LineBreakHL && (prev == LineBreakHY || prev == LineBreakBA):
+		return true
+	case prev == LineBreakSY && class == LineBreakHL:
+		return true
+
+	// LB22: don't break before ellipses.
+	case class == LineBreakIN:
+		return true
+
+	// LB23 to LB25: don't break within numbers, nor between them and letters,
+	// following the regular expression of the example 7 for LB25.
+	case isAlphabetic(prev) && class == LineBreakNU, prev == LineBreakNU && isAlphabetic(class):
+		return true
+	case prev == LineBreakPR && (class == LineBreakID || class == LineBreakEB || class == LineBreakEM):
+		return true
+	case (prev == LineBreakID || prev == LineBreakEB || prev == LineBreakEM) && class == LineBreakPO:
+		return true
+	case (prev == LineBreakPR || prev == LineBreakPO) && isAlphabetic(class):
+		return true
+	case isAlphabetic(prev) && (class == LineBreakPR || class == LineBreakPO):
+		return true
+	case (prev == LineBreakPR || prev == LineBreakPO) && (class == LineBreakNU || (class == LineBreakOP || class == LineBreakHY) && next == LineBreakNU):
+		return true
+	case (prev == LineBreakOP || prev == LineBreakHY) && class == LineBreakNU:
+		return true
+	case s.number && (class == LineBreakNU || class == LineBreakSY || class == LineBreakIS || class == LineBreakCL || class == LineBreakCP):
+		return true
+	case (s.number || s.closedNumber) && (class == LineBreakPO || class == LineBreakPR):
+		return true
+
+	// LB26, LB27: don't break within Korean syllables.
+	case prev == LineBreakJL && (class == LineBreakJL || class == LineBreakJV || class == LineBreakH2 || class == LineBreakH3):
+		return true
+	case (prev == LineBreakJV || prev == LineBreakH2) && (class == LineBreakJV || class == LineBreakJT):
+		return true
+	case (prev == LineBreakJT || prev == LineBreakH3) && class == LineBreakJT:
+		return true
+	case isHangul(prev) && class == LineBreakPO, prev == LineBreakPR && isHangul(class):
+		return true
+
+	// LB28, LB29: don't break between letters, nor after infix separators
+	// before them.
+	case isAlphabetic(prev) && isAlphabetic(class):
+		return true
+	case prev == LineBreakIS && isAlphabetic(class):
+		return true
+
+	// LB30: don't break between letters or numbers and narrow parentheses.
+	case (isAlphabetic(prev) || prev == LineBreakNU) && class == LineBreakOP && !lineBreakIsWide(r):
+		return true
+	case prev == LineBreakCP && !lineBreakIsWide(s.prevRune) && (isAlphabetic(class) || class == LineBreakNU):
+		return true
+
+	// LB30a: don't break within pairs of regional indicators.
+	case prev == LineBreakRI && class == LineBreakRI && s.regional%2 == 1:
+		return true
+	}
+
+	return false
+}
+
+// lineBreakIsWide reports whether the punctuation r is East Asian wide, as
+// needed by LB30.
+func lineBreakIsWide(r rune) bool {
+	return r == 0x2329 || r >= 0x3000 && r <= 0xFFEF
+}
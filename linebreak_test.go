@@ -0,0 +1,157 @@
+package hb
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// lineBreakTests are written in the format of LineBreakTest.txt from the
+// Unicode Character Database: the code points of the text, with ÷ where a line
+// may break and × where it may not, including at the start and the end.
+const lineBreakTests = `
+× 0041 × 0020 ÷ 0042 ÷       	# LB18 break after spaces
+× 0041 × 000A ÷ 0042 ÷       	# LB5 LF
+× 0041 × 000D × 000A ÷ 0042 ÷	# LB5 CR LF
+× 0041 × 000D ÷ 0042 ÷       	# LB5 CR
+× 0041 × 0020 × 0020 ÷ 0042 ÷	# LB7 no break before spaces
+× 0041 × 200B × 0020 ÷ 0042 ÷	# LB8 break after zero width space and spaces
+× 0041 × 0301 × 0042 ÷       	# LB9 combining mark
+× 0020 ÷ 0301 ÷              	# LB10 combining mark after space
+× 0041 × 2060 × 0042 ÷       	# LB11 word joiner
+× 0041 × 00A0 × 0042 ÷       	# LB12 non-breaking space
+× 0020 ÷ 00A0 ÷              	# LB12a non-breaking space after space
+× 0041 × 0029 ÷              	# LB13 close parenthesis
+× 0028 × 0020 × 0041 ÷       	# LB14 open punctuation and spaces
+× 0022 × 0020 × 0028 ÷       	# LB15 quotation mark, spaces and open punctuation
+× 0029 × 0020 × 3005 ÷       	# LB16 close punctuation, spaces and nonstarter
+× 2014 × 0020 × 2014 ÷       	# LB17 em dashes and spaces
+× 0041 × 0022 × 0042 ÷       	# LB19 quotation marks
+× 0041 × 002D ÷ 0042 ÷       	# LB21 hyphen
+× 05D0 × 002D × 05D1 ÷       	# LB21a Hebrew letter and hyphen
+× 0041 × 2026 ÷              	# LB22 ellipsis
+× 0031 × 0025 ÷              	# LB25 number and postfix
+× 0024 × 0031 ÷              	# LB25 prefix and number
+× 0024 × 0028 × 0031 ÷       	# LB25 prefix, parenthesis and number
+× 0031 × 002C × 0032 ÷       	# LB25 number, comma and number
+× 0031 × 0029 × 0025 ÷       	# LB25 number, parenthesis and postfix
+× 0041 × 0028 ÷              	# LB30 narrow open parenthesis
+× 0041 ÷ 300C ÷              	# LB30 wide open punctuation
+× 0029 × 0041 ÷              	# LB30 close parenthesis and letter
+× 1100 × 1161 × 11A8 ÷       	# LB26 Korean jamos
+× AC00 × 11A8 ÷              	# LB26 Korean syllable and jamo
+× 1F1E6 × 1F1E7 ÷ 1F1E8 ÷    	# LB30a regional indicators
+× 1F466 × 1F3FB ÷            	# LB30b emoji modifier
+× 4E00 ÷ 4E01 ÷              	# LB31 ideographs
+× 4E00 × 3002 ÷              	# LB13 ideograph and ideographic full stop
+× 0041 × 200D × 4E00 ÷       	# LB8a zero width joiner
+× 0E01 × 0E02 ÷              	# LB1 complex context as alphabetic
+× 0041 × 2028 ÷ 0042 ÷       	# LB4 line separator
+× 0041 × 0085 ÷ 0042 ÷       	# LB5 next line
+× 0041 × 00AD ÷ 0042 ÷       	# LB21 soft hyphen
+× 00B4 × 0041 ÷              	# LB21 break before
+× 0041 ÷ FFFC ÷ 0042 ÷       	# LB20 contingent break
+× 0031 × 0020 ÷ 0025 ÷       	# LB18 number, space and postfix
+× 4E00 × 3041 ÷              	# LB1 conditional Japanese starter
+× 0041 × 0009 ÷ 0042 ÷       	# LB21 tab
+`
+
+func TestFindLineBreaks(t *testing.T) {
+	for _, line := range strings.Split(lineBreakTests, "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		var text strings.Builder
+		var want []int
+		for i, field := range fields {
+			if i%2 == 0 {
+				if field == "÷" && i > 0 {
+					want = append(want, text.Len())
+				}
+				continue
+			}
+
+			r, err := strconv.ParseUint(field, 16, 32)
+			if err != nil {
+				t.Fatalf("%q: %v", line, err)
+			}
+			text.WriteRune(rune(r))
+		}
+
+		var got []int
+		for _, brk := range FindLineBreaks(text.String()) {
+			got = append(got, brk.Offset)
+		}
+
+		if !equalInts(got, want) {
+			t.Errorf("%s: got breaks at %v, want %v", strings.TrimSpace(line), got, want)
+		}
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFindLineBreaksMandatory(t *testing.T) {
+	got := FindLineBreaks("a b\nc\r\nd\u2028e")
+	want := []LineBreak{{2, false}, {4, true}, {7, true}, {11, true}, {12, true}}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+			break
+		}
+	}
+
+	if breaks := FindLineBreaks(""); len(breaks) != 0 {
+		t.Errorf("got %v for an empty text, want no breaks", breaks)
+	}
+}
+
+func TestLineBreakClassOf(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want LineBreakClass
+	}{
+		{'a', LineBreakAL},
+		{' ', LineBreakSP},
+		{'\n', LineBreakLF},
+		{'0', LineBreakNU},
+		{'(', LineBreakOP},
+		{')', LineBreakCP},
+		{'\u00A0', LineBreakGL},
+		{'\u05D0', LineBreakHL},
+		{'\u0E01', LineBreakSA},
+		{'\u200B', LineBreakZW},
+		{'\u200D', LineBreakZWJ},
+		{'\u3041', LineBreakCJ},
+		{'\u4E00', LineBreakID},
+		{'\uAC00', LineBreakH2},
+		{'\U0001F1E6', LineBreakRI},
+		{'\U0001F466', LineBreakEB},
+		{'\U0001F3FB', LineBreakEM},
+		{'\U000E0080', LineBreakXX},
+		{0x10FFFF, LineBreakXX},
+	}
+
+	for _, test := range tests {
+		if got := LineBreakClassOf(test.r); got != test.want {
+			t.Errorf("LineBreakClassOf(%U): got %d, want %d", test.r, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package hb
+
+// #include <stdlib.h>
+// #include <string.h>
+// #include <hb.h>
+//
+// typedef struct {
+// 	unsigned int text_offset;
+// 	unsigned int text_length;
+// 	unsigned int features_offset;
+// 	unsigned int features_length;
+// 	hb_buffer_flags_t flags;
+// 	hb_buffer_cluster_level_t cluster_level;
+// 	hb_codepoint_t not_found_glyph;
+//
+// 	// In: the requested properties. Out: the properties shaped with.
+// 	hb_direction_t direction;
+// 	hb_script_t script;
+// 	hb_language_t language;
+//
+// 	// Out: the range of the glyphs in the packed results.
+// 	unsigned int glyphs_offset;
+// 	unsigned int glyphs_length;
+// 	hb_bool_t successful;
+// } hb_go_shape_request_t;
+//
+// typedef struct {
+// 	hb_glyph_info_t *infos;
+// 	hb_glyph_position_t *positions;
+// 	hb_glyph_extents_t *extents;
+// 	unsigned int length;
+// } hb_go_shape_results_t;
+//
+// static hb_bool_t hb_go_shape_results_grow(hb_go_shape_results_t *results, unsigned int *allocated, unsigned int size) {
+// 	if (size <= *allocated)
+// 		return 1;
+//
+// 	unsigned int n = *allocated * 2;
+// 	if (n < size)
+// 		n = size;
+//
+// 	hb_glyph_info_t *infos = realloc(results->infos, n * sizeof(*infos));
+// 	if (infos)
+// 		results->infos = infos;
+// 	hb_glyph_position_t *positions = realloc(results->positions, n * sizeof(*positions));
+// 	if (positions)
+// 		results->positions = positions;
+// 	hb_glyph_extents_t *extents = realloc(results->extents, n * sizeof(*extents));
+// 	if (extents)
+// 		results->extents = extents;
+//
+// 	if (!infos || !positions || !extents)
+// 		return 0;
+//
+// 	*allocated = n;
+// 	return 1;
+// }
+//
+// static hb_bool_t hb_go_shape_batch(hb_font_t *font, const char *text, const hb_feature_t *features, hb_go_shape_request_t *requests, unsigned int count, hb_go_shape_results_t *results) {
+// 	hb_buffer_t *buffer = hb_buffer_create();
+// 	unsigned int allocated = 0;
+//
+// 	for (unsigned int i = 0; i < count; i++) {
+// 		hb_go_shape_request_t *r = &requests[i];
+//
+// 		hb_buffer_clear_contents(buffer);
+// 		hb_buffer_add_utf8(buffer, text + r->text_offset, r->text_length, 0, r->text_length);
+// 		if (r->direction != HB_DIRECTION_INVALID)
+// 			hb_buffer_set_direction(buffer, r->direction);
+// 		if (r->script != HB_SCRIPT_INVALID)
+// 			hb_buffer_set_script(buffer, r->script);
+// 		if (r->language)
+// 			hb_buffer_set_language(buffer, r->language);
+// 		hb_buffer_set_flags(buffer, r->flags);
+// 		hb_buffer_set_cluster_level(buffer, r->cluster_level);
+// 		hb_buffer_set_not_found_glyph(buffer, r->not_found_glyph);
+// 		hb_buffer_guess_segment_properties(buffer);
+//
+// 		hb_shape(font, buffer, r->features_length ? features + r->features_offset : NULL, r->features_length);
+//
+// 		hb_segment_properties_t props;
+// 		hb_buffer_get_segment_properties(buffer, &props);
+// 		r->direction = props.direction;
+// 		r->script = props.script;
+// 		r->language = props.language;
+//
+// 		r->glyphs_offset = results->length;
+// 		r->glyphs_length = 0;
+// 		r->successful = hb_buffer_allocation_successful(buffer);
+// 		if (!r->successful)
+// 			continue;
+//
+// 		unsigned int length;
+// 		hb_glyph_info_t *infos = hb_buffer_get_glyph_infos(buffer, &length);
+// 		hb_glyph_position_t *positions = hb_buffer_get_glyph_positions(buffer, NULL);
+// 		if (!hb_go_shape_results_grow(results, &allocated, results->length + length)) {
+// 			hb_buffer_destroy(buffer);
+// 			return 0;
+// 		}
+//
+// 		memcpy(results->infos + results->length, infos, length * sizeof(*infos));
+// 		memcpy(results->positions + results->length, positions, length * sizeof(*positions));
+// 		for (unsigned int j = 0; j < length; j++) {
+// 			hb_glyph_extents_t *extents = &results->extents[results->length + j];
+// 			if (!hb_font_get_glyph_extents(font, infos[j].codepoint, extents))
+// 				memset(extents, 0, sizeof(*extents));
+// 		}
+//
+// 		r->glyphs_length = length;
+// 		results->length += length;
+// 	}
+//
+// 	hb_buffer_destroy(buffer);
+// 	return 1;
+// }
+import "C"
+import "unsafe"
+
+// ShapeRequest is a single text to shape with ShapeBatch.
+type ShapeRequest struct {
+	Text    string       // The UTF-8 encoded text to shape.
+	Options ShapeOptions // The options to shape the text with, see ShapeText.
+}
+
+// ShapeBatch shapes every request's text with font, the same as ShapeText
+// does, and returns the runs in the order of the requests.
+//
+// Unlike calling ShapeText for each text, the whole batch is shaped within a
+// single cgo call, which saves most of the call overhead when shaping many
+// short texts, such as UI labels.
+func ShapeBatch(font Font, requests []ShapeRequest) ([]ShapedRun, error) {
+	if font == nil {
+		return nil, ErrNilFont
+	}
+	if len(requests) == 0 {
+		return nil, nil
+	}
+
+	// The texts and features are packed into single slices, referenced by
+	// offset, since C can't be handed memory holding Go pointers.
+	var textLength, featuresLength int
+	for i := range requests {
+		textLength += len(requests[i].Text)
+		featuresLength += len(requests[i].Options.Features)
+	}
+
+	text := make([]byte, 0, textLength)
+	features := make([]Feature, 0, featuresLength)
+	cRequests := make([]C.hb_go_shape_request_t, len(requests))
+	for i := range requests {
+		req := &requests[i]
+
+		cRequests[i] = C.hb_go_shape_request_t{
+			text_offset:     C.uint(len(text)),
+			text_length:     C.uint(len(req.Text)),
+			features_offset: C.uint(len(features)),
+			features_length: C.uint(len(req.Options.Features)),
+			flags:           C.hb_buffer_flags_t(req.Options.Flags),
+			cluster_level:   C.hb_buffer_cluster_level_t(req.Options.ClusterLevel),
+			not_found_glyph: C.hb_codepoint_t(req.Options.NotFoundGlyph),
+			direction:       C.hb_direction_t(req.Options.Direction),
+			script:          C.hb_script_t(req.Options.Script),
+			language:        C.hb_language_t(req.Options.Language),
+		}
+
+		text = append(text, req.Text...)
+		features = append(features, req.Options.Features...)
+	}
+
+	var results C.hb_go_shape_results_t
+	defer func() {
+		C.free(unsafe.Pointer(results.infos))
+		C.free(unsafe.Pointer(results.positions))
+		C.free(unsafe.Pointer(results.extents))
+	}()
+
+	if C.hb_go_shape_batch(font, cBytes(text), cFeatures(features), &cRequests[0], C.uint(len(cRequests)), &results) == 0 {
+		return nil, ErrAllocationFailed
+	}
+
+	length := int(results.length)
+	infos := unsafe.Slice((*GlyphInfo)(unsafe.Pointer(results.infos)), length)
+	positions := unsafe.Slice((*GlyphPosition)(unsafe.Pointer(results.positions)), length)
+	extents := unsafe.Slice((*GlyphExtents)(unsafe.Pointer(results.extents)), length)
+
+	runs := make([]ShapedRun, len(requests))
+	for i, r := range cRequests {
+		if r.successful == 0 {
+			return nil, ErrAllocationFailed
+		}
+
+		start, end := int(r.glyphs_offset), int(r.glyphs_offset+r.glyphs_length)
+		props := SegmentProperties{
+			Direction: Direction(r.direction),
+			Script:    Script(r.script),
+			Language:  Language(r.language),
+		}
+		glyphExtents := func(j int, glyph Codepoint) (GlyphExtents, bool) {
+			return extents[start+j], true
+		}
+
+		runs[i] = *buildShapedRun(infos[start:end], positions[start:end], props, len(requests[i].Text), requests[i].Options.Scale, glyphExtents)
+	}
+
+	return runs, nil
+}
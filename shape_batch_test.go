@@ -0,0 +1,41 @@
+package hb
+
+import "testing"
+
+// batchBenchmarkRequests are short UI labels, the case ShapeBatch is for.
+var batchBenchmarkRequests = func() []ShapeRequest {
+	labels := []string{"OK", "Cancel", "Save as…", "Open recent", "Preferences", "Quit", "ذخیره", "בטל"}
+
+	requests := make([]ShapeRequest, 0, 16*len(labels))
+	for i := 0; i < 16; i++ {
+		for _, label := range labels {
+			requests = append(requests, ShapeRequest{Text: label})
+		}
+	}
+
+	return requests
+}()
+
+func BenchmarkShapeBatch(b *testing.B) {
+	font := testFont(b, "HB_TEST_FONT")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ShapeBatch(font, batchBenchmarkRequests); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkShapeTextPerCall(b *testing.B) {
+	font := testFont(b, "HB_TEST_FONT")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, req := range batchBenchmarkRequests {
+			if _, err := ShapeText(font, req.Text, req.Options); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
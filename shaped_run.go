@@ -0,0 +1,219 @@
+package hb
+
+import (
+	"errors"
+	"sort"
+)
+
+var (
+	// ErrNilFont is returned when shaping is requested with a nil Font.
+	ErrNilFont = errors.New("hb: nil font")
+
+	// ErrAllocationFailed is returned when HarfBuzz fails to allocate the memory
+	// needed for shaping.
+	ErrAllocationFailed = errors.New("hb: buffer allocation failed")
+)
+
+// ShapeOptions holds the options of ShapeText. The zero value shapes the text
+// with the segment properties guessed from it, and the default features.
+type ShapeOptions struct {
+	Direction    Direction    // The text direction, guessed from the text if DirectionInvalid.
+	Script       Script       // The text script, guessed from the text if ScriptInvalid.
+	Language     Language     // The text language, the default language if nil.
+	Features     []Feature    // The features to apply, see Shape.
+	Flags        BufferFlags  // The buffer flags, see BufferSetFlags.
+	ClusterLevel ClusterLevel // The cluster level, see BufferSetClusterLevel.
+
+	// NotFoundGlyph replaces the characters not found in the font, see
+	// BufferSetNotFoundGlyph. Zero means the .notdef glyph.
+	NotFoundGlyph Codepoint
+
+	// Scale is multiplied by every position and metric of the result, to
+	// convert them from the font's scale to the unit of choice. For example
+	// 1.0/64 converts 26.6 fixed-point values to pixels. Zero means 1.
+	Scale float32
+}
+
+// ShapedGlyph is a single glyph of a ShapedRun.
+type ShapedGlyph struct {
+	ID      Codepoint  // The glyph index in the font.
+	Cluster uint32     // The cluster of the glyph, a byte offset in the text.
+	Start   int        // The start of the text bytes the glyph's cluster covers.
+	End     int        // The end of the text bytes the glyph's cluster covers.
+	Flags   GlyphFlags // The glyph flags, see GlyphInfoGetGlyphFlags.
+
+	XAdvance float32 // How much the line advances after drawing the glyph horizontally.
+	YAdvance float32 // How much the line advances after drawing the glyph vertically.
+	XOffset  float32 // How much the glyph moves on the X-axis before drawing it.
+	YOffset  float32 // How much the glyph moves on the Y-axis before drawing it.
+}
+
+// ShapedRun is the result of shaping a text with ShapeText.
+type ShapedRun struct {
+	Glyphs     []ShapedGlyph     // The shaped glyphs, in visual order.
+	Properties SegmentProperties // The segment properties the text was shaped with.
+
+	XAdvance float32 // The sum of all of the glyphs' XAdvance.
+	YAdvance float32 // The sum of all of the glyphs' YAdvance.
+
+	// The ink bounds of the run, relative to the pen position of the first
+	// glyph, in a Y-up coordinate system. They're all zero if the run has no
+	// inked glyph.
+	InkMinX, InkMinY, InkMaxX, InkMaxY float32
+}
+
+// ShapeText shapes the UTF-8 encoded text with font, and returns the shaped
+// glyphs along with their metrics.
+func ShapeText(font Font, text string, opts ShapeOptions) (*ShapedRun, error) {
+	if font == nil {
+		return nil, ErrNilFont
+	}
+
+	buffer := BufferCreate()
+	defer BufferDestroy(buffer)
+
+	return shapeTextWithBuffer(font, buffer, text, &opts)
+}
+
+// shapeTextWithBuffer shapes text using buffer, which must be empty.
+func shapeTextWithBuffer(font Font, buffer Buffer, text string, opts *ShapeOptions) (*ShapedRun, error) {
+	bufferSetupText(buffer, text, opts)
+
+	Shape(font, buffer, opts.Features)
+	if !BufferAllocationSuccessful(buffer) {
+		return nil, ErrAllocationFailed
+	}
+
+	return newShapedRun(font, buffer, len(text), opts.Scale), nil
+}
+
+// bufferSetupText adds text to buffer and sets the buffer properties according
+// to opts.
+func bufferSetupText(buffer Buffer, text string, opts *ShapeOptions) {
+	BufferAddUTF8(buffer, text)
+
+	if opts.Direction != DirectionInvalid {
+		BufferSetDirection(buffer, opts.Direction)
+	}
+	if opts.Script != ScriptInvalid {
+		BufferSetScript(buffer, opts.Script)
+	}
+	if opts.Language != nil {
+		BufferSetLanguage(buffer, opts.Language)
+	}
+	BufferSetFlags(buffer, opts.Flags)
+	BufferSetClusterLevel(buffer, opts.ClusterLevel)
+	BufferSetNotFoundGlyph(buffer, opts.NotFoundGlyph)
+
+	BufferGuessSegmentProperties(buffer)
+}
+
+// newShapedRun builds a ShapedRun from the shaped buffer, whose clusters are
+// byte offsets of a text of textLength bytes.
+func newShapedRun(font Font, buffer Buffer, textLength int, scale float32) *ShapedRun {
+	glyphExtents := func(i int, glyph Codepoint) (GlyphExtents, bool) {
+		return FontGetGlyphExtents(font, glyph)
+	}
+
+	return buildShapedRun(BufferGetGlyphInfosView(buffer), BufferGetGlyphPositionsView(buffer), BufferGetSegmentProperties(buffer), textLength, scale, glyphExtents)
+}
+
+// buildShapedRun builds a ShapedRun from shaped glyphs, whose clusters are byte
+// offsets of a text of textLength bytes. glyphExtents returns the extents of
+// the i-th glyph.
+func buildShapedRun(infos []GlyphInfo, positions []GlyphPosition, props SegmentProperties, textLength int, scale float32, glyphExtents func(i int, glyph Codepoint) (GlyphExtents, bool)) *ShapedRun {
+	if scale == 0 {
+		scale = 1
+	}
+
+	run := &ShapedRun{
+		Glyphs:     make([]ShapedGlyph, len(infos)),
+		Properties: props,
+	}
+
+	ends := clusterEnds(infos, textLength)
+
+	var penX, penY int32
+	var inked bool
+	for i, info := range infos {
+		pos := positions[i]
+
+		run.Glyphs[i] = ShapedGlyph{
+			ID:       info.Codepoint,
+			Cluster:  info.Cluster,
+			Start:    int(info.Cluster),
+			End:      ends[info.Cluster],
+			Flags:    GlyphFlags(info.mask) & GlyphFlagDefined,
+			XAdvance: float32(pos.XAdvance) * scale,
+			YAdvance: float32(pos.YAdvance) * scale,
+			XOffset:  float32(pos.XOffset) * scale,
+			YOffset:  float32(pos.YOffset) * scale,
+		}
+
+		if extents, ok := glyphExtents(i, info.Codepoint); ok && extents.Width != 0 && extents.Height != 0 {
+			x0 := float32(penX+pos.XOffset+extents.XBearing) * scale
+			y0 := float32(penY+pos.YOffset+extents.YBearing) * scale
+			x1 := x0 + float32(extents.Width)*scale
+			y1 := y0 + float32(extents.Height)*scale
+			if x0 > x1 {
+				x0, x1 = x1, x0
+			}
+			if y0 > y1 {
+				y0, y1 = y1, y0
+			}
+
+			if !inked {
+				run.InkMinX, run.InkMinY, run.InkMaxX, run.InkMaxY = x0, y0, x1, y1
+				inked = true
+			} else {
+				run.InkMinX = minFloat32(run.InkMinX, x0)
+				run.InkMinY = minFloat32(run.InkMinY, y0)
+				run.InkMaxX = maxFloat32(run.InkMaxX, x1)
+				run.InkMaxY = maxFloat32(run.InkMaxY, y1)
+			}
+		}
+
+		penX += pos.XAdvance
+		penY += pos.YAdvance
+	}
+
+	run.XAdvance = float32(penX) * scale
+	run.YAdvance = float32(penY) * scale
+
+	return run
+}
+
+// clusterEnds maps every cluster of infos to the offset where it ends, which
+// is the next greater cluster, or textLength for the last one.
+func clusterEnds(infos []GlyphInfo, textLength int) map[uint32]int {
+	clusters := make([]uint32, 0, len(infos))
+	for _, info := range infos {
+		clusters = append(clusters, info.Cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i] < clusters[j] })
+
+	ends := make(map[uint32]int, len(clusters))
+	for i := len(clusters) - 1; i >= 0; i-- {
+		if i == len(clusters)-1 {
+			ends[clusters[i]] = textLength
+		} else if clusters[i] != clusters[i+1] {
+			ends[clusters[i]] = int(clusters[i+1])
+		}
+	}
+
+	return ends
+}
+
+func minFloat32(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxFloat32(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,197 @@
+package hb
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	// ErrShaperDestroyed is returned when a Shaper is used after Destroy.
+	ErrShaperDestroyed = errors.New("hb: shaper destroyed")
+
+	// ErrFaceMismatch is returned when a Shaper is given a font of another face.
+	ErrFaceMismatch = errors.New("hb: font face does not match the shaper's")
+
+	// ErrShapingFailed is returned when none of the shapers could shape the
+	// buffer.
+	ErrShapingFailed = errors.New("hb: shaping failed")
+)
+
+// Shaper shapes buffers with the fonts of a single Face, caching the shape
+// plans it creates. It's safe for concurrent use.
+//
+// Plans are keyed by the buffer's SegmentProperties, the user features, the
+// font's normalized variation coordinates and the Shaper's shaper list. The
+// cache is never evicted: every distinct key adds a plan, which is only
+// released by Destroy. Fonts whose variations keep changing, such as animated
+// ones, should be shaped with ShapeFull or a Shaper recreated from time to
+// time instead, see ShaperStats.Plans.
+type Shaper struct {
+	face       Face
+	shaperList []string
+
+	mu    sync.RWMutex
+	plans map[uint32][]*shaperPlan // nil once the Shaper is destroyed.
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// shaperPlan is a cached plan, along with the key it's created for.
+type shaperPlan struct {
+	props    SegmentProperties
+	features []Feature
+	coords   []int32
+	plan     ShapePlan
+}
+
+// ShaperStats holds the cache statistics of a Shaper.
+type ShaperStats struct {
+	Hits   uint64 // The number of times a cached plan was used.
+	Misses uint64 // The number of times a new plan was created.
+	Plans  int    // The number of cached plans.
+}
+
+// NewShaper creates a Shaper for face. shaperList is the list of shapers to
+// try, in order; nil means the default list, see ShapeFull.
+//
+// The Shaper holds a reference to face until it's destroyed by Destroy.
+func NewShaper(face Face, shaperList []string) *Shaper {
+	return &Shaper{
+		face:       FaceReference(face),
+		shaperList: append([]string(nil), shaperList...),
+		plans:      make(map[uint32][]*shaperPlan),
+	}
+}
+
+// Shape shapes the buffer with font, which must be created from the Shaper's
+// face, or ErrFaceMismatch is returned. The buffer's segment properties must be
+// set beforehand, e.g. by BufferGuessSegmentProperties.
+//
+// It returns ErrShapingFailed if none of the shapers could shape the buffer,
+// and ErrShaperDestroyed once the Shaper is destroyed.
+func (s *Shaper) Shape(font Font, buffer Buffer, features []Feature) error {
+	if font == nil {
+		return ErrNilFont
+	}
+	if FontGetFace(font) != s.face {
+		return ErrFaceMismatch
+	}
+
+	props := BufferGetSegmentProperties(buffer)
+	plan, err := s.plan(&props, features, FontGetVarCoordsNormalized(font))
+	if err != nil {
+		return err
+	}
+	defer ShapePlanDestroy(plan)
+
+	if !ShapePlanExecute(plan, font, buffer, features) {
+		return ErrShapingFailed
+	}
+
+	return nil
+}
+
+// Stats returns the cache statistics of the Shaper.
+func (s *Shaper) Stats() ShaperStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := ShaperStats{Hits: s.hits.Load(), Misses: s.misses.Load()}
+	for _, bucket := range s.plans {
+		stats.Plans += len(bucket)
+	}
+
+	return stats
+}
+
+// Destroy releases the cached plans and the face reference of the Shaper.
+// Shapes running meanwhile finish with the plans they use, and later ones
+// return ErrShaperDestroyed.
+func (s *Shaper) Destroy() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.plans == nil {
+		return
+	}
+
+	for _, bucket := range s.plans {
+		for _, p := range bucket {
+			ShapePlanDestroy(p.plan)
+		}
+	}
+	s.plans = nil
+
+	FaceDestroy(s.face)
+}
+
+// plan returns a reference to the cached plan for the given key, creating it
+// if needed. The reference keeps the plan alive even if the Shaper is
+// destroyed meanwhile, and must be released with ShapePlanDestroy.
+func (s *Shaper) plan(props *SegmentProperties, features []Feature, coords []int32) (ShapePlan, error) {
+	hash := SegmentPropertiesHash(props)
+
+	s.mu.RLock()
+	if s.plans == nil {
+		s.mu.RUnlock()
+		return nil, ErrShaperDestroyed
+	}
+	if p := findShaperPlan(s.plans[hash], props, features, coords); p != nil {
+		plan := ShapePlanReference(p.plan)
+		s.mu.RUnlock()
+		s.hits.Add(1)
+		return plan, nil
+	}
+	s.mu.RUnlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.plans == nil {
+		return nil, ErrShaperDestroyed
+	}
+
+	// Another goroutine may have created it in the meantime.
+	if p := findShaperPlan(s.plans[hash], props, features, coords); p != nil {
+		s.hits.Add(1)
+		return ShapePlanReference(p.plan), nil
+	}
+
+	p := &shaperPlan{
+		props:    *props,
+		features: append([]Feature(nil), features...),
+		coords:   coords,
+		plan:     ShapePlanCreate2(s.face, props, features, coords, s.shaperList),
+	}
+	s.plans[hash] = append(s.plans[hash], p)
+	s.misses.Add(1)
+
+	return ShapePlanReference(p.plan), nil
+}
+
+// findShaperPlan finds the plan matching the given key in bucket.
+func findShaperPlan(bucket []*shaperPlan, props *SegmentProperties, features []Feature, coords []int32) *shaperPlan {
+	for _, p := range bucket {
+		if SegmentPropertiesEqual(&p.props, props) && equalSlices(p.features, features) && equalSlices(p.coords, coords) {
+			return p
+		}
+	}
+
+	return nil
+}
+
+func equalSlices[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,197 @@
+package hb
+
+import "sync"
+
+// SharedFont is a font which is safe to use from many goroutines at once.
+//
+// HarfBuzz objects are reference counted atomically, and functions which only
+// read from a face or a font, such as Shape or the FontGet functions, may be
+// called concurrently. Setters, however, must never run while the object is in
+// use by another goroutine. A SharedFont rules them out by making the font and
+// its face immutable, after which HarfBuzz ignores any change made to them.
+// Never pass its Font to FontSetScale or the other setters, as the change is
+// silently dropped.
+//
+// To change a SharedFont, use its Set methods instead, which transparently
+// switch it to a sub-font of the original font with all the changes made so
+// far applied, see FontCreateSubFont. Derive, and the With methods built on it,
+// create a new SharedFont the same way, leaving the original untouched.
+//
+// Buffers are never safe to share, every goroutine must use its own, see
+// BufferPool.
+type SharedFont struct {
+	mu       sync.Mutex // guards font and settings
+	root     Font       // the font passed to NewSharedFont
+	font     Font       // root, or a sub-font of it with settings applied
+	settings sharedFontSettings
+}
+
+// sharedFontSettings holds the changes made by the Set methods of a
+// SharedFont, which are applied to every new sub-font of its root font.
+type sharedFontSettings struct {
+	scale         bool
+	scaleX        int32
+	scaleY        int32
+	ppem          bool
+	ppemX         uint32
+	ppemY         uint32
+	ptem          bool
+	ptemValue     float32
+	variations    bool
+	variationList []Variation // set by SetVariations
+	axes          []Variation // set by SetVariation since, one per tag
+}
+
+// apply makes the changes of s to font.
+func (s *sharedFontSettings) apply(font Font) {
+	if s.scale {
+		FontSetScale(font, s.scaleX, s.scaleY)
+	}
+	if s.ppem {
+		FontSetPpem(font, s.ppemX, s.ppemY)
+	}
+	if s.ptem {
+		FontSetPtem(font, s.ptemValue)
+	}
+	if s.variations {
+		FontSetVariations(font, s.variationList)
+	}
+	for _, axis := range s.axes {
+		FontSetVariation(font, axis.Tag, axis.Value)
+	}
+}
+
+// NewSharedFont makes font and its face immutable, and returns a SharedFont
+// holding a reference to font. Finish setting up the font before calling it.
+//
+// Close the SharedFont using Destroy.
+func NewSharedFont(font Font) *SharedFont {
+	FaceMakeImmutable(FontGetFace(font))
+	FontMakeImmutable(font)
+
+	return &SharedFont{root: FontReference(font), font: FontReference(font)}
+}
+
+// Font returns the current font, which may only be passed to functions that
+// don't modify it, such as Shape, ShapeText or ShapeBatch. It's valid until
+// the SharedFont is changed by a Set method or destroyed; goroutines which may
+// run alongside a Set method must use Acquire instead.
+func (f *SharedFont) Font() Font {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.font
+}
+
+// Acquire returns a reference to the current font, which stays valid even if
+// the SharedFont is changed or destroyed meanwhile. Release it using
+// FontDestroy once done, after which the font is freed if the SharedFont has
+// moved on to another one.
+func (f *SharedFont) Acquire() Font {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return FontReference(f.font)
+}
+
+// Derive returns a new SharedFont from a sub-font of f, which is set up by set
+// before it's made immutable. f itself is left untouched.
+//
+// Close the returned SharedFont using Destroy.
+func (f *SharedFont) Derive(set func(font Font)) *SharedFont {
+	font := f.Acquire()
+	defer FontDestroy(font)
+
+	sub := FontCreateSubFont(font)
+	defer FontDestroy(sub)
+
+	set(sub)
+
+	return NewSharedFont(sub)
+}
+
+// WithScale returns a new SharedFont with the scale set, see FontSetScale.
+func (f *SharedFont) WithScale(x, y int32) *SharedFont {
+	return f.Derive(func(font Font) { FontSetScale(font, x, y) })
+}
+
+// WithPpem returns a new SharedFont with the ppem set, see FontSetPpem.
+func (f *SharedFont) WithPpem(x, y uint32) *SharedFont {
+	return f.Derive(func(font Font) { FontSetPpem(font, x, y) })
+}
+
+// WithPtem returns a new SharedFont with the ptem set, see FontSetPtem.
+func (f *SharedFont) WithPtem(ptem float32) *SharedFont {
+	return f.Derive(func(font Font) { FontSetPtem(font, ptem) })
+}
+
+// WithVariations returns a new SharedFont with the variations set, see
+// FontSetVariations.
+func (f *SharedFont) WithVariations(variations []Variation) *SharedFont {
+	return f.Derive(func(font Font) { FontSetVariations(font, variations) })
+}
+
+// SetScale changes the scale of f, see FontSetScale.
+func (f *SharedFont) SetScale(x, y int32) {
+	f.set(func(s *sharedFontSettings) { s.scale, s.scaleX, s.scaleY = true, x, y })
+}
+
+// SetPpem changes the ppem of f, see FontSetPpem.
+func (f *SharedFont) SetPpem(x, y uint32) {
+	f.set(func(s *sharedFontSettings) { s.ppem, s.ppemX, s.ppemY = true, x, y })
+}
+
+// SetPtem changes the ptem of f, see FontSetPtem.
+func (f *SharedFont) SetPtem(ptem float32) {
+	f.set(func(s *sharedFontSettings) { s.ptem, s.ptemValue = true, ptem })
+}
+
+// SetVariations changes the variations of f, see FontSetVariations.
+func (f *SharedFont) SetVariations(variations []Variation) {
+	variations = append([]Variation(nil), variations...)
+	f.set(func(s *sharedFontSettings) { s.variations, s.variationList, s.axes = true, variations, nil })
+}
+
+// SetVariation changes a single variation axis of f, see FontSetVariation.
+func (f *SharedFont) SetVariation(tag Tag, value float32) {
+	f.set(func(s *sharedFontSettings) {
+		for i := range s.axes {
+			if s.axes[i].Tag == tag {
+				s.axes[i].Value = value
+				return
+			}
+		}
+		s.axes = append(s.axes, Variation{Tag: tag, Value: value})
+	})
+}
+
+// set records change in the settings of f, and replaces its font with an
+// immutable sub-font of the root font with all the settings applied. The
+// replaced font is released, and freed once the goroutines that acquired it
+// are done with it.
+func (f *SharedFont) set(change func(s *sharedFontSettings)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	change(&f.settings)
+
+	sub := FontCreateSubFont(f.root)
+	f.settings.apply(sub)
+	FontMakeImmutable(sub)
+
+	FontDestroy(f.font)
+	f.font = sub
+}
+
+// Destroy releases the SharedFont's references to its fonts. The SharedFont,
+// and the Fonts it returned from Font, must not be used afterwards, while the
+// ones from Acquire stay valid until released.
+func (f *SharedFont) Destroy() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	FontDestroy(f.font)
+	FontDestroy(f.root)
+
+	f.font, f.root = nil, nil
+}
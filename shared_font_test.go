@@ -0,0 +1,99 @@
+package hb
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestSharedFontConcurrentShape shapes with one SharedFont from many
+// goroutines while it's being changed. Run it with -race.
+func TestSharedFontConcurrentShape(t *testing.T) {
+	shared := NewSharedFont(testFont(t, "HB_TEST_FONT"))
+	defer shared.Destroy()
+
+	want := shapeSharedFont(shared.Font())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			font := shared.Acquire()
+			defer FontDestroy(font)
+
+			for j := 0; j < 100; j++ {
+				if got := shapeSharedFont(font); got != want {
+					t.Errorf("got %d glyphs, want %d", got, want)
+					return
+				}
+			}
+		}()
+	}
+
+	for i := int32(1); i <= 10; i++ {
+		shared.SetScale(i*64, i*64)
+	}
+
+	wg.Wait()
+}
+
+func shapeSharedFont(font Font) uint32 {
+	buffer := BufferCreate()
+	defer BufferDestroy(buffer)
+
+	BufferAddUTF8(buffer, "Hello, World! سلام دنیا")
+	BufferGuessSegmentProperties(buffer)
+	Shape(font, buffer, nil)
+
+	return BufferGetLength(buffer)
+}
+
+func TestSharedFontSet(t *testing.T) {
+	shared := NewSharedFont(testFont(t, "HB_TEST_FONT"))
+	defer shared.Destroy()
+
+	old := shared.Acquire()
+	defer FontDestroy(old)
+	oldX, oldY := FontGetScale(old)
+
+	shared.SetScale(123, 456)
+
+	if x, y := FontGetScale(shared.Font()); x != 123 || y != 456 {
+		t.Errorf("got scale %d, %d; want 123, 456", x, y)
+	}
+	if !FontIsImmutable(shared.Font()) {
+		t.Error("the new font is mutable")
+	}
+	if x, y := FontGetScale(old); x != oldX || y != oldY {
+		t.Errorf("the old font changed to scale %d, %d; want %d, %d", x, y, oldX, oldY)
+	}
+}
+
+// TestSharedFontSetRoot checks the Set methods build on the original font,
+// rather than stacking sub-fonts, and keep the earlier changes.
+func TestSharedFontSetRoot(t *testing.T) {
+	root := testFont(t, "HB_TEST_FONT")
+	shared := NewSharedFont(root)
+	defer shared.Destroy()
+
+	for i := int32(1); i <= 10; i++ {
+		shared.SetScale(i*64, i*64)
+		shared.SetPpem(uint32(i), uint32(i))
+	}
+	shared.SetPtem(12)
+
+	font := shared.Font()
+	if parent := FontGetParent(font); parent != root {
+		t.Errorf("got parent %p, want the original font %p", parent, root)
+	}
+	if x, y := FontGetScale(font); x != 640 || y != 640 {
+		t.Errorf("got scale %d, %d; want 640, 640", x, y)
+	}
+	if x, y := FontGetPpem(font); x != 10 || y != 10 {
+		t.Errorf("got ppem %d, %d; want 10, 10", x, y)
+	}
+	if ptem := FontGetPtem(font); ptem != 12 {
+		t.Errorf("got ptem %v, want 12", ptem)
+	}
+}
@@ -0,0 +1,333 @@
+package hb
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// WordCacheStats holds the statistics of a WordCache.
+type WordCacheStats struct {
+	Hits      uint64 // The number of words found in the cache.
+	Misses    uint64 // The number of words shaped and added to the cache.
+	Fallbacks uint64 // The number of texts shaped as a whole, as the words couldn't be spliced.
+	Entries   int    // The number of words currently cached.
+}
+
+// WordCache shapes texts word by word, caching the shaped words so that they
+// are reused across texts, the same as browsers do. It's safe for concurrent
+// use.
+//
+// Words are keyed by the font, along with its serial, so that changes to the
+// font invalidate them, the segment properties, features, variations and the
+// rest of the ShapeOptions, and the word text.
+//
+// The cache holds a reference to every font it has words of, so that a font
+// destroyed by its owner can't be mistaken for a new one created at the same
+// address. The references are released once all of the font's words are
+// evicted, or dropped by Clear.
+type WordCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[wordKey]*list.Element
+	lru     *list.List
+	fonts   map[Font]int // The number of entries of each font referenced.
+	stats   WordCacheStats
+}
+
+// wordKey is the key of a cached word.
+type wordKey struct {
+	font         Font // Referenced by the cache while it has words of it.
+	serial       uint32
+	direction    Direction
+	script       Script
+	language     Language
+	flags        BufferFlags
+	clusterLevel ClusterLevel
+	notFound     Codepoint
+	scale        float32
+	features     string
+	coords       string
+	text         string
+}
+
+// wordEntry is a cached word.
+type wordEntry struct {
+	key wordKey
+	run *ShapedRun
+}
+
+// NewWordCache creates a WordCache holding up to maxEntries words, dropping
+// the least recently used ones once it's full. Zero or less means no limit.
+func NewWordCache(maxEntries int) *WordCache {
+	return &WordCache{
+		maxEntries: maxEntries,
+		entries:    make(map[wordKey]*list.Element),
+		lru:        list.New(),
+		fonts:      make(map[Font]int),
+	}
+}
+
+// ShapeText shapes the UTF-8 encoded text with font the same as ShapeText does,
+// splicing the cached runs of its words together.
+//
+// Words are split after spaces, and shaped with the segment properties of the
+// whole text. If shaping any two adjacent words separately may give a
+// different result than shaping them together, as told by
+// GlyphFlagUnsafeToConcat, or some of the features don't apply to the whole
+// text, the text is shaped as a whole instead.
+func (c *WordCache) ShapeText(font Font, text string, opts ShapeOptions) (*ShapedRun, error) {
+	if font == nil {
+		return nil, ErrNilFont
+	}
+
+	buffer := BufferCreate()
+	defer BufferDestroy(buffer)
+
+	if !featuresGlobal(opts.Features) {
+		c.fallback()
+		return shapeTextWithBuffer(font, buffer, text, &opts)
+	}
+
+	// The text is set up as a whole first, to use the same properties for all
+	// of the words, and to be shaped right away if they can't be spliced.
+	bufferSetupText(buffer, text, &opts)
+	props := BufferGetSegmentProperties(buffer)
+
+	wordOpts := opts
+	wordOpts.Direction = props.Direction
+	wordOpts.Script = props.Script
+	wordOpts.Language = props.Language
+	wordOpts.Flags |= BufferFlagProduceUnsafeToConcat
+
+	key := wordKey{
+		font:         font,
+		serial:       FontGetSerial(font),
+		direction:    props.Direction,
+		script:       props.Script,
+		language:     props.Language,
+		flags:        wordOpts.Flags,
+		clusterLevel: opts.ClusterLevel,
+		notFound:     opts.NotFoundGlyph,
+		scale:        opts.Scale,
+		features:     sliceKey(opts.Features),
+		coords:       sliceKey(FontGetVarCoordsNormalized(font)),
+	}
+
+	words := splitWords(text)
+	runs := make([]*ShapedRun, len(words))
+	for i, word := range words {
+		key.text = text[word.Start:word.End]
+
+		run, err := c.word(font, key, &wordOpts)
+		if err != nil {
+			return nil, err
+		}
+		runs[i] = run
+	}
+
+	if !canSpliceWords(runs, words) {
+		c.fallback()
+
+		Shape(font, buffer, opts.Features)
+		if !BufferAllocationSuccessful(buffer) {
+			return nil, ErrAllocationFailed
+		}
+
+		return newShapedRun(font, buffer, len(text), opts.Scale), nil
+	}
+
+	return spliceWords(runs, words, props), nil
+}
+
+// Stats returns the statistics of the cache.
+func (c *WordCache) Stats() WordCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Entries = len(c.entries)
+
+	return stats
+}
+
+// Clear drops all of the cached words, and releases the cache's references to
+// their fonts.
+func (c *WordCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for font := range c.fonts {
+		FontDestroy(font)
+	}
+
+	c.entries = make(map[wordKey]*list.Element)
+	c.lru.Init()
+	c.fonts = make(map[Font]int)
+}
+
+// word returns the cached run of the word, shaping and caching it if needed.
+func (c *WordCache) word(font Font, key wordKey, opts *ShapeOptions) (*ShapedRun, error) {
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(e)
+		c.stats.Hits++
+		c.mu.Unlock()
+		return e.Value.(*wordEntry).run, nil
+	}
+	c.mu.Unlock()
+
+	buffer := BufferCreate()
+	defer BufferDestroy(buffer)
+
+	run, err := shapeTextWithBuffer(font, buffer, key.text, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stats.Misses++
+	if _, ok := c.entries[key]; !ok {
+		key.text = strings.Clone(key.text)
+		c.entries[key] = c.lru.PushFront(&wordEntry{key: key, run: run})
+
+		if c.fonts[key.font] == 0 {
+			FontReference(key.font)
+		}
+		c.fonts[key.font]++
+
+		if c.maxEntries > 0 && c.lru.Len() > c.maxEntries {
+			oldest := c.lru.Back()
+			c.lru.Remove(oldest)
+
+			oldestKey := oldest.Value.(*wordEntry).key
+			delete(c.entries, oldestKey)
+
+			c.fonts[oldestKey.font]--
+			if c.fonts[oldestKey.font] == 0 {
+				delete(c.fonts, oldestKey.font)
+				FontDestroy(oldestKey.font)
+			}
+		}
+	}
+
+	return run, nil
+}
+
+func (c *WordCache) fallback() {
+	c.mu.Lock()
+	c.stats.Fallbacks++
+	c.mu.Unlock()
+}
+
+// featuresGlobal reports whether all of the features apply to the whole text.
+func featuresGlobal(features []Feature) bool {
+	for _, f := range features {
+		if f.Start != FeatureGlobalStart || f.End != FeatureGlobalEnd {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sliceKey returns the raw bytes of s as a string, to be used in map keys.
+func sliceKey[T Feature | int32](s []T) string {
+	if len(s) == 0 {
+		return ""
+	}
+
+	var zero T
+	return string(unsafe.Slice((*byte)(unsafe.Pointer(&s[0])), len(s)*int(unsafe.Sizeof(zero))))
+}
+
+// splitWords splits text after every run of spaces.
+func splitWords(text string) []TextRange {
+	var words []TextRange
+
+	start := 0
+	for i := 0; i < len(text); i++ {
+		if text[i] == ' ' && (i+1 == len(text) || text[i+1] != ' ') {
+			words = append(words, TextRange{Start: start, End: i + 1})
+			start = i + 1
+		}
+	}
+	if start < len(text) || len(words) == 0 {
+		words = append(words, TextRange{Start: start, End: len(text)})
+	}
+
+	return words
+}
+
+// canSpliceWords reports whether the separately shaped runs of the words can
+// be concatenated, which is when neither the last cluster of a word nor the
+// first cluster of the next one is unsafe to concat.
+func canSpliceWords(runs []*ShapedRun, words []TextRange) bool {
+	for i, run := range runs {
+		length := words[i].End - words[i].Start
+
+		for _, g := range run.Glyphs {
+			if g.Flags&GlyphFlagUnsafeToConcat == 0 {
+				continue
+			}
+			if (g.Start == 0 && i > 0) || (g.End == length && i+1 < len(runs)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// spliceWords concatenates the runs of the words into a run of the whole text,
+// in visual order.
+func spliceWords(runs []*ShapedRun, words []TextRange, props SegmentProperties) *ShapedRun {
+	var length int
+	for _, run := range runs {
+		length += len(run.Glyphs)
+	}
+
+	result := &ShapedRun{
+		Glyphs:     make([]ShapedGlyph, 0, length),
+		Properties: props,
+	}
+
+	var inked bool
+	for i := range runs {
+		if DirectionIsBackward(props.Direction) {
+			i = len(runs) - 1 - i
+		}
+		run, offset := runs[i], words[i].Start
+
+		for _, g := range run.Glyphs {
+			g.Cluster += uint32(offset)
+			g.Start += offset
+			g.End += offset
+			result.Glyphs = append(result.Glyphs, g)
+		}
+
+		if run.InkMinX != run.InkMaxX && run.InkMinY != run.InkMaxY {
+			x0, y0 := result.XAdvance+run.InkMinX, result.YAdvance+run.InkMinY
+			x1, y1 := result.XAdvance+run.InkMaxX, result.YAdvance+run.InkMaxY
+
+			if !inked {
+				result.InkMinX, result.InkMinY, result.InkMaxX, result.InkMaxY = x0, y0, x1, y1
+				inked = true
+			} else {
+				result.InkMinX = minFloat32(result.InkMinX, x0)
+				result.InkMinY = minFloat32(result.InkMinY, y0)
+				result.InkMaxX = maxFloat32(result.InkMaxX, x1)
+				result.InkMaxY = maxFloat32(result.InkMaxY, y1)
+			}
+		}
+
+		result.XAdvance += run.XAdvance
+		result.YAdvance += run.YAdvance
+	}
+
+	return result
+}
@@ -0,0 +1,299 @@
+package hb
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// Line is a line of text wrapped by WrapText.
+type Line struct {
+	Start int // The byte offset of the line in the text.
+	End   int // The byte offset of the end of the line, after its trailing whitespace.
+
+	// Run holds the glyphs of the line, in visual order. Their clusters are
+	// byte offsets in the whole text.
+	Run *ShapedRun
+
+	// Width is the advance of the line along its direction, without its
+	// trailing whitespace, which may hang past the width the text is wrapped
+	// to.
+	Width float32
+
+	Mandatory bool // Whether the line ends at a mandatory break, such as a newline.
+}
+
+// WrapText shapes the UTF-8 encoded text with font and opts, and breaks it
+// into lines no wider than width, in the unit of opts.Scale, at the line break
+// opportunities found by FindLineBreaks. A line is only wider when a single
+// unbreakable part of the text doesn't fit.
+//
+// The text is shaped once as a whole, and the candidate lines are measured
+// with its advances. The glyphs around a break are reshaped only if it falls
+// on a glyph marked GlyphFlagUnsafeToBreak, or inside a cluster, and only
+// up to the nearest safe break within the line, so that every line is shaped
+// as if on its own. With ClusterLevelCharacters, whose clusters may not be
+// monotone, every line is reshaped.
+func WrapText(font Font, text string, width float32, opts ShapeOptions) ([]Line, error) {
+	if font == nil {
+		return nil, ErrNilFont
+	}
+
+	buffer := BufferCreate()
+	defer BufferDestroy(buffer)
+
+	bufferSetupText(buffer, text, &opts)
+	Shape(font, buffer, opts.Features)
+	if !BufferAllocationSuccessful(buffer) {
+		return nil, ErrAllocationFailed
+	}
+
+	w := newLineWrapper(font, buffer, text, &opts)
+	defer BufferDestroy(w.scratch)
+
+	breaks := FindLineBreaks(text)
+
+	var lines []Line
+	for start, i := 0, 0; i < len(breaks); {
+		// Take the furthest break whose line fits, or the first one if none
+		// does.
+		j := i
+		for !breaks[j].Mandatory && j+1 < len(breaks) && w.measure(start, breaks[j+1].Offset) <= width {
+			j++
+		}
+
+		// Reshaping the edges of the line may make it wider than measured.
+		line, err := w.line(start, breaks[j])
+		for err == nil && line.Width > width && j > i {
+			j--
+			line, err = w.line(start, breaks[j])
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, line)
+		start, i = breaks[j].Offset, j+1
+	}
+
+	return lines, nil
+}
+
+// lineWrapper holds the text shaped as a whole by WrapText, and builds its
+// lines.
+type lineWrapper struct {
+	font    Font
+	text    string
+	opts    *ShapeOptions
+	scratch Buffer
+
+	props     SegmentProperties
+	vertical  bool
+	infos     []GlyphInfo
+	positions []GlyphPosition
+
+	// advances[o] is the advance of the clusters before the byte offset o,
+	// and safe[o] reports whether the text can be broken before o without
+	// reshaping.
+	advances []int32
+	safe     []bool
+}
+
+// newLineWrapper creates a lineWrapper for text, shaped in buffer, which must
+// outlive it. Its scratch buffer must be destroyed by the caller.
+func newLineWrapper(font Font, buffer Buffer, text string, opts *ShapeOptions) *lineWrapper {
+	w := &lineWrapper{
+		font:      font,
+		text:      text,
+		opts:      opts,
+		scratch:   BufferCreate(),
+		props:     BufferGetSegmentProperties(buffer),
+		infos:     BufferGetGlyphInfosView(buffer),
+		positions: BufferGetGlyphPositionsView(buffer),
+		advances:  make([]int32, len(text)+1),
+		safe:      make([]bool, len(text)+1),
+	}
+	w.vertical = DirectionIsVertical(w.props.Direction)
+
+	unsafe := make([]bool, len(text)+1)
+	for i, info := range w.infos {
+		w.advances[info.Cluster+1] += w.advance(w.positions[i])
+
+		if opts.ClusterLevel != ClusterLevelCharacters {
+			w.safe[info.Cluster] = true
+		}
+		if GlyphFlags(info.mask)&GlyphFlagUnsafeToBreak != 0 {
+			unsafe[info.Cluster] = true
+		}
+	}
+
+	for o := range w.safe {
+		if o > 0 {
+			w.advances[o] += w.advances[o-1]
+		}
+		w.safe[o] = w.safe[o] && !unsafe[o]
+	}
+	w.safe[0], w.safe[len(text)] = true, true
+
+	return w
+}
+
+// advance returns the advance of a glyph along the direction of the text.
+func (w *lineWrapper) advance(pos GlyphPosition) int32 {
+	if w.vertical {
+		return -pos.YAdvance
+	}
+	return pos.XAdvance
+}
+
+// scale returns the scale of the results.
+func (w *lineWrapper) scale() float32 {
+	if w.opts.Scale == 0 {
+		return 1
+	}
+	return w.opts.Scale
+}
+
+// trim returns the end of the text in [start, end), without its trailing
+// whitespace.
+func (w *lineWrapper) trim(start, end int) int {
+	for end > start {
+		r, size := utf8.DecodeLastRuneInString(w.text[start:end])
+		switch LineBreakClassOf(r) {
+		case LineBreakSP, LineBreakZW, LineBreakBK, LineBreakCR, LineBreakLF, LineBreakNL:
+			end -= size
+		default:
+			return end
+		}
+	}
+
+	return end
+}
+
+// measure returns the width of the line spanning [start, end), using the
+// advances of the text shaped as a whole.
+func (w *lineWrapper) measure(start, end int) float32 {
+	return float32(w.advances[w.trim(start, end)]-w.advances[start]) * w.scale()
+}
+
+// line builds the line starting at start and ending at brk, reshaping its
+// edges which aren't safe to break.
+func (w *lineWrapper) line(start int, brk LineBreak) (Line, error) {
+	end := brk.Offset
+
+	// The glyphs of [left, right) are kept from the text shaped as a whole.
+	left, right := start, end
+	for left < end && !w.safe[left] {
+		left++
+	}
+	for right > left && !w.safe[right] {
+		right--
+	}
+
+	var pieces [3]struct {
+		infos     []GlyphInfo
+		positions []GlyphPosition
+	}
+
+	if left > start {
+		infos, positions, err := w.shapeFragment(start, end, start, left)
+		if err != nil {
+			return Line{}, err
+		}
+		pieces[0].infos, pieces[0].positions = infos, positions
+	}
+
+	lo, hi := w.glyphRange(left, right)
+	pieces[1].infos, pieces[1].positions = w.infos[lo:hi], w.positions[lo:hi]
+
+	if right < end {
+		infos, positions, err := w.shapeFragment(start, end, right, end)
+		if err != nil {
+			return Line{}, err
+		}
+		pieces[2].infos, pieces[2].positions = infos, positions
+	}
+
+	if DirectionIsBackward(w.props.Direction) {
+		pieces[0], pieces[2] = pieces[2], pieces[0]
+	}
+
+	var infos []GlyphInfo
+	var positions []GlyphPosition
+	for _, piece := range pieces {
+		infos = append(infos, piece.infos...)
+		positions = append(positions, piece.positions...)
+	}
+
+	glyphExtents := func(i int, glyph Codepoint) (GlyphExtents, bool) {
+		return FontGetGlyphExtents(w.font, glyph)
+	}
+	run := buildShapedRun(infos, positions, w.props, end, w.opts.Scale, glyphExtents)
+
+	var width int32
+	trimmed := w.trim(start, end)
+	for i, info := range infos {
+		if int(info.Cluster) < trimmed {
+			width += w.advance(positions[i])
+		}
+	}
+
+	return Line{
+		Start:     start,
+		End:       end,
+		Run:       run,
+		Width:     float32(width) * w.scale(),
+		Mandatory: brk.Mandatory,
+	}, nil
+}
+
+// glyphRange returns the range of the glyphs of the text shaped as a whole
+// whose clusters are in [start, end), which is contiguous as clusters are
+// monotone.
+func (w *lineWrapper) glyphRange(start, end int) (lo, hi int) {
+	if start >= end {
+		return 0, 0
+	}
+
+	if DirectionIsBackward(w.props.Direction) {
+		lo = sort.Search(len(w.infos), func(i int) bool { return int(w.infos[i].Cluster) < end })
+		hi = sort.Search(len(w.infos), func(i int) bool { return int(w.infos[i].Cluster) < start })
+	} else {
+		lo = sort.Search(len(w.infos), func(i int) bool { return int(w.infos[i].Cluster) >= start })
+		hi = sort.Search(len(w.infos), func(i int) bool { return int(w.infos[i].Cluster) >= end })
+	}
+
+	return lo, hi
+}
+
+// shapeFragment shapes the text in [start, end) of the line spanning
+// [lineStart, lineEnd), with the rest of the line as context, and returns its
+// glyphs with their clusters as offsets in the whole text.
+func (w *lineWrapper) shapeFragment(lineStart, lineEnd, start, end int) ([]GlyphInfo, []GlyphPosition, error) {
+	BufferReset(w.scratch)
+	BufferAddUTF8Item(w.scratch, w.text[lineStart:lineEnd], start-lineStart, end-start)
+	BufferSetSegmentProperties(w.scratch, w.props)
+	BufferSetClusterLevel(w.scratch, w.opts.ClusterLevel)
+	BufferSetNotFoundGlyph(w.scratch, w.opts.NotFoundGlyph)
+
+	flags := w.opts.Flags
+	if start > 0 {
+		flags &^= BufferFlagBot
+	}
+	if end < len(w.text) {
+		flags &^= BufferFlagEot
+	}
+	BufferSetFlags(w.scratch, flags)
+
+	Shape(w.font, w.scratch, w.opts.Features)
+	if !BufferAllocationSuccessful(w.scratch) {
+		return nil, nil, ErrAllocationFailed
+	}
+
+	infos := append([]GlyphInfo(nil), BufferGetGlyphInfosView(w.scratch)...)
+	positions := append([]GlyphPosition(nil), BufferGetGlyphPositionsView(w.scratch)...)
+	for i := range infos {
+		infos[i].Cluster += uint32(lineStart)
+	}
+
+	return infos, positions, nil
+}
@@ -0,0 +1,133 @@
+package hb
+
+import "testing"
+
+// wrapTestFont kerns the letters after spaces, which makes HarfBuzz mark them
+// unsafe to break, so that lines starting with them are reshaped.
+func wrapTestFont(tb testing.TB) Font {
+	return buildTestFont(tb, testFontSpec{
+		runes: "abcdefgh \nאבגד",
+		kerns: []testKern{{' ', 'c', 50}, {' ', 'ג', 50}},
+	})
+}
+
+func TestWrapText(t *testing.T) {
+	type wantLine struct {
+		start, end int
+		width      float32
+		mandatory  bool
+		clusters   []uint32 // The clusters of the glyphs, in visual order.
+	}
+
+	tests := []struct {
+		name  string
+		text  string
+		width float32
+		want  []wantLine
+	}{
+		{
+			name:  "mandatory",
+			text:  "ab\nde",
+			width: 1000,
+			want: []wantLine{
+				{0, 3, 200, true, []uint32{0, 1, 2}},
+				{3, 5, 200, true, []uint32{3, 4}},
+			},
+		},
+		{
+			name:  "wider word",
+			text:  "abdefg h",
+			width: 300,
+			want: []wantLine{
+				{0, 7, 600, false, []uint32{0, 1, 2, 3, 4, 5, 6}},
+				{7, 8, 100, true, []uint32{7}},
+			},
+		},
+		{
+			name:  "hanging spaces",
+			text:  "ab   ef",
+			width: 200,
+			want: []wantLine{
+				{0, 5, 200, false, []uint32{0, 1, 2, 3, 4}},
+				{5, 7, 200, true, []uint32{5, 6}},
+			},
+		},
+		{
+			// Shaped as a whole, c is kerned after the space. Reshaped at the
+			// start of its line, it's not.
+			name:  "unsafe to break",
+			text:  "ab cd",
+			width: 250,
+			want: []wantLine{
+				{0, 3, 200, false, []uint32{0, 1, 2}},
+				{3, 5, 200, true, []uint32{3, 4}},
+			},
+		},
+		{
+			// The reshaped start of the second line goes to its right end.
+			name:  "rtl",
+			text:  "אב גד",
+			width: 250,
+			want: []wantLine{
+				{0, 5, 200, false, []uint32{4, 2, 0}},
+				{5, 9, 200, true, []uint32{7, 5}},
+			},
+		},
+	}
+
+	font := wrapTestFont(t)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lines, err := WrapText(font, test.text, test.width, ShapeOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(lines) != len(test.want) {
+				t.Fatalf("got %d lines, want %d", len(lines), len(test.want))
+			}
+
+			for i, want := range test.want {
+				line := lines[i]
+				if line.Start != want.start || line.End != want.end || line.Width != want.width || line.Mandatory != want.mandatory {
+					t.Errorf("line %d: got [%d, %d) of width %v, mandatory %v; want [%d, %d) of width %v, mandatory %v",
+						i, line.Start, line.End, line.Width, line.Mandatory, want.start, want.end, want.width, want.mandatory)
+				}
+
+				var clusters []uint32
+				for _, glyph := range line.Run.Glyphs {
+					clusters = append(clusters, glyph.Cluster)
+				}
+				if !equalUint32s(clusters, want.clusters) {
+					t.Errorf("line %d: got clusters %v, want %v", i, clusters, want.clusters)
+				}
+			}
+		})
+	}
+}
+
+func TestWrapTextReshape(t *testing.T) {
+	font := wrapTestFont(t)
+
+	lines, err := WrapText(font, "ab cd", 250, ShapeOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The kerned advance of c shaped as a whole is 150.
+	if got := lines[1].Run.Glyphs[0].XAdvance; got != 100 {
+		t.Errorf("got an advance of %v for the reshaped glyph, want 100", got)
+	}
+}
+
+func equalUint32s(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}